@@ -19,6 +19,8 @@ package common
 import (
 	"fmt"
 	"runtime"
+	"sort"
+	"strings"
 )
 
 const (
@@ -62,9 +64,16 @@ const (
 //   a Map which contains the set of headers to be included in the REST API request
 //
 func GetSdkHeaders(serviceName string, serviceVersion string, operationId string) map[string]string {
+	return GetSdkHeadersWithApplication(serviceName, serviceVersion, operationId, nil)
+}
+
+// GetSdkHeadersWithApplication - returns the set of SDK-specific headers to be included in an outgoing request
+// sent on behalf of the application "application", which is identified in the User-Agent header (see
+// GetUserAgentInfoWithApplication). A nil application yields the same headers as GetSdkHeaders.
+func GetSdkHeadersWithApplication(serviceName string, serviceVersion string, operationId string, application *ApplicationInfo) map[string]string {
 	sdkHeaders := make(map[string]string)
 
-	sdkHeaders[headerNameUserAgent] = GetUserAgentInfo()
+	sdkHeaders[headerNameUserAgent] = GetUserAgentInfoWithApplication(application)
 
 	return sdkHeaders
 }
@@ -75,6 +84,68 @@ func GetUserAgentInfo() string {
 	return userAgent
 }
 
+// ApplicationInfo identifies the application that uses the SDK in the User-Agent header of its requests.
+type ApplicationInfo struct {
+	// The name of the application (e.g. "deployer").
+	Name string
+
+	// The version of the application (e.g. "1.4.2").
+	Version string
+
+	// Additional key/value tokens (e.g. {"team": "infra"}).
+	Tokens map[string]string
+}
+
+// String returns the User-Agent product of the application, e.g. "deployer/1.4.2 (team=infra)".
+// Characters that are not allowed in a product name, version or comment are replaced with '-'.
+func (application *ApplicationInfo) String() string {
+	product := sanitizeUserAgentToken(application.Name, userAgentSeparators)
+	if product == "" {
+		product = "unknown"
+	}
+	if application.Version != "" {
+		product += "/" + sanitizeUserAgentToken(application.Version, userAgentSeparators)
+	}
+
+	keys := make([]string, 0, len(application.Tokens))
+	for key := range application.Tokens {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	tokens := make([]string, 0, len(keys))
+	for _, key := range keys {
+		tokens = append(tokens, sanitizeUserAgentToken(key, "()\\;= ")+"="+sanitizeUserAgentToken(application.Tokens[key], "()\\;"))
+	}
+	if len(tokens) > 0 {
+		product += " (" + strings.Join(tokens, "; ") + ")"
+	}
+	return product
+}
+
+// GetUserAgentInfoWithApplication returns the User-Agent header value for requests sent on behalf of the application
+// "application": the SDK's own User-Agent information followed by the application's product, e.g.
+// "schematics-go-sdk/0.0.1 (lang=go; arch=amd64; os=linux; go.version=go1.23.0) deployer/1.4.2 (team=infra)".
+// A nil application yields the same value as GetUserAgentInfo.
+func GetUserAgentInfoWithApplication(application *ApplicationInfo) string {
+	if application == nil {
+		return userAgent
+	}
+	return userAgent + " " + application.String()
+}
+
+// userAgentSeparators are the characters that are not allowed in the product name and version of a User-Agent.
+const userAgentSeparators = "()<>@,;:\\\"/[]?={} "
+
+// sanitizeUserAgentToken replaces control characters and the characters in "separators" with '-'.
+func sanitizeUserAgentToken(token string, separators string) string {
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f || r > 0x7e || strings.ContainsRune(separators, r) {
+			return '-'
+		}
+		return r
+	}, strings.TrimSpace(token))
+}
+
 var systemInfo = fmt.Sprintf("(lang=go; arch=%s; os=%s; go.version=%s)", runtime.GOARCH, runtime.GOOS, runtime.Version())
 
 func GetSystemInfo() string {
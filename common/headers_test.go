@@ -41,3 +41,21 @@ func TestGetSdkHeaders(t *testing.T) {
 	assert.True(t, foundIt)
 	t.Logf("user agent: %s\n", headers[headerNameUserAgent])
 }
+
+func TestGetSdkHeadersWithApplication(t *testing.T) {
+	application := &ApplicationInfo{
+		Name:    "deployer",
+		Version: "1.4.2",
+		Tokens:  map[string]string{"team": "infra", "env": "prod"},
+	}
+	var headers = GetSdkHeadersWithApplication("myService", "v123", "myOperation", application)
+	assert.Equal(t, GetUserAgentInfo()+" deployer/1.4.2 (env=prod; team=infra)", headers[headerNameUserAgent])
+
+	assert.Equal(t, GetUserAgentInfo(), GetUserAgentInfoWithApplication(nil))
+}
+
+func TestApplicationInfoString(t *testing.T) {
+	assert.Equal(t, "my-tool", (&ApplicationInfo{Name: "my tool"}).String())
+	assert.Equal(t, "unknown/1.0-beta", (&ApplicationInfo{Version: "1.0/beta"}).String())
+	assert.Equal(t, "tool (a-b=c-d)", (&ApplicationInfo{Name: "tool", Tokens: map[string]string{"a;b": "c)d"}}).String())
+}
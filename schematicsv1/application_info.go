@@ -0,0 +1,44 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"maps"
+
+	common "github.com/IBM/schematics-go-sdk/common"
+)
+
+// SetApplicationInfo identifies the application that uses the instance: the application's name, version and
+// tokens are appended to the User-Agent header of every request sent by the instance, so that the requests can be
+// attributed to the application by IBM support. A nil "application" restores the default User-Agent header.
+// Clones created afterwards inherit the application information.
+func (schematics *SchematicsV1) SetApplicationInfo(application *common.ApplicationInfo) {
+	schematics.application = nil
+	if application != nil {
+		schematics.application = &common.ApplicationInfo{
+			Name:    application.Name,
+			Version: application.Version,
+			Tokens:  maps.Clone(application.Tokens),
+		}
+	}
+}
+
+// GetSdkHeaders returns the SDK-specific headers sent with the requests of the operation "operationID" by the
+// instance, including the application information set with SetApplicationInfo.
+func (schematics *SchematicsV1) GetSdkHeaders(operationID string) map[string]string {
+	return common.GetSdkHeadersWithApplication("schematics", "V1", operationID, schematics.application)
+}
@@ -0,0 +1,80 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/schematics-go-sdk/common"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Application info`, func() {
+	var testServer *httptest.Server
+	var schematicsService *schematicsv1.SchematicsV1
+	var userAgents []string
+
+	BeforeEach(func() {
+		userAgents = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			userAgents = append(userAgents, req.Header.Get("User-Agent"))
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			res.Write([]byte(`{"id": "testString"}`))
+		}))
+
+		var serviceErr error
+		schematicsService, serviceErr = schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Identify the application per instance`, func() {
+		schematicsService.Service.SetDefaultHeaders(http.Header{"X-Team": {"infra"}})
+		schematicsService.SetApplicationInfo(&common.ApplicationInfo{
+			Name:    "deployer",
+			Version: "1.4.2",
+			Tokens:  map[string]string{"env": "prod"},
+		})
+		clone := schematicsService.Clone()
+		clone.SetApplicationInfo(&common.ApplicationInfo{Name: "auditor"})
+
+		expectedUserAgent := common.GetUserAgentInfo() + " deployer/1.4.2 (env=prod)"
+		Expect(schematicsService.GetSdkHeaders("GetWorkspace")).To(Equal(map[string]string{
+			"User-Agent": expectedUserAgent,
+		}))
+
+		_, _, operationErr := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("testString"))
+		Expect(operationErr).To(BeNil())
+		_, _, operationErr = clone.GetWorkspace(clone.NewGetWorkspaceOptions("testString"))
+		Expect(operationErr).To(BeNil())
+		Expect(userAgents).To(Equal([]string{expectedUserAgent, common.GetUserAgentInfo() + " auditor"}))
+		Expect(schematicsService.Service.DefaultHeaders.Get("X-Team")).To(Equal("infra"))
+
+		schematicsService.SetApplicationInfo(nil)
+		Expect(schematicsService.GetSdkHeaders("GetWorkspace")["User-Agent"]).To(Equal(common.GetUserAgentInfo()))
+	})
+})
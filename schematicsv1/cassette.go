@@ -0,0 +1,295 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// CassetteMode : The mode of a Cassette.
+type CassetteMode int
+
+// The modes of a Cassette.
+const (
+	// CassetteRecord : Requests are sent to the service, and the interactions are recorded.
+	CassetteRecord CassetteMode = iota
+
+	// CassetteReplay : Requests are answered with the recorded interactions, without being sent.
+	CassetteReplay
+)
+
+// CassetteRequest : A recorded request.
+type CassetteRequest struct {
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+// CassetteResponse : A recorded response.
+type CassetteResponse struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// CassetteInteraction : A recorded request and its response.
+type CassetteInteraction struct {
+	OperationID string           `json:"operation_id"`
+	Request     CassetteRequest  `json:"request"`
+	Response    CassetteResponse `json:"response"`
+}
+
+// CassetteOptions : The options for OpenCassette.
+type CassetteOptions struct {
+	// The names of additional headers, query parameters and JSON properties whose values are scrubbed from the
+	// recorded interactions, in addition to the DefaultRedactedFields. Names are matched case-insensitively.
+	RedactFields []string
+}
+
+// Cassette : A recording of the interactions of a SchematicsV1 instance with the service, stored as a JSON file,
+// which allows a test suite to run against a live account once and then offline.
+//
+// In record mode, the requests are sent to the service and the interactions are recorded, with secrets scrubbed as
+// described for NewLoggingInterceptor. In replay mode, the requests are answered with the recorded interactions:
+// each request is matched to the first unused interaction with the same method, path and query, regardless of the
+// host of the service URL. The authenticator of the instance is not part of the recording, so replaying
+// instances should use an authenticator that does not call out to the network, such as core.NoAuthAuthenticator.
+type Cassette struct {
+	path     string
+	mode     CassetteMode
+	redactor *redactor
+
+	mutex        sync.Mutex
+	interactions []CassetteInteraction
+	used         []bool
+}
+
+// cassetteFile is the format of a cassette file.
+type cassetteFile struct {
+	Interactions []CassetteInteraction `json:"interactions"`
+}
+
+// OpenCassette opens the cassette stored in the file "path" in "mode". In record mode, the cassette starts empty and
+// is written to the file by Save. In replay mode, the interactions are read from the file.
+func OpenCassette(path string, mode CassetteMode, options *CassetteOptions) (*Cassette, error) {
+	if options == nil {
+		options = &CassetteOptions{}
+	}
+	cassette := &Cassette{
+		path:     path,
+		mode:     mode,
+		redactor: newRedactor(options.RedactFields),
+	}
+	if mode == CassetteReplay {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var file cassetteFile
+		if err := json.Unmarshal(data, &file); err != nil {
+			return nil, fmt.Errorf("error reading cassette '%s': %w", path, err)
+		}
+		cassette.interactions = file.Interactions
+		cassette.used = make([]bool, len(file.Interactions))
+	}
+	return cassette, nil
+}
+
+// UseCassette installs "cassette" in the interceptor chain of the instance. It should be the last interceptor of
+// the chain, so that the other interceptors also run when the interactions are replayed.
+func (schematics *SchematicsV1) UseCassette(cassette *Cassette) {
+	schematics.Use(cassette.Interceptor())
+}
+
+// Mode returns the mode of the cassette.
+func (cassette *Cassette) Mode() CassetteMode {
+	return cassette.mode
+}
+
+// Interactions returns a copy of the interactions of the cassette.
+func (cassette *Cassette) Interactions() []CassetteInteraction {
+	cassette.mutex.Lock()
+	defer cassette.mutex.Unlock()
+	return append([]CassetteInteraction{}, cassette.interactions...)
+}
+
+// Save writes the recorded interactions to the file of the cassette. It does nothing in replay mode.
+func (cassette *Cassette) Save() error {
+	if cassette.mode != CassetteRecord {
+		return nil
+	}
+	cassette.mutex.Lock()
+	data, err := json.MarshalIndent(cassetteFile{Interactions: cassette.interactions}, "", "  ")
+	cassette.mutex.Unlock()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(cassette.path, append(data, '\n'), 0600)
+}
+
+// Interceptor returns the interceptor that records or replays the requests of a SchematicsV1 instance.
+func (cassette *Cassette) Interceptor() Interceptor {
+	return func(call *Call, next Handler) (*http.Response, error) {
+		if cassette.mode == CassetteReplay {
+			return cassette.replay(call)
+		}
+		return cassette.record(call, next)
+	}
+}
+
+// record sends the request of "call" and records the interaction.
+func (cassette *Cassette) record(call *Call, next Handler) (*http.Response, error) {
+	var requestBody []byte
+	if call.Request.GetBody != nil {
+		body, err := call.Request.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		requestBody, err = io.ReadAll(body)
+		body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	response, err := next(call)
+	if err != nil {
+		return response, err
+	}
+	responseBody, err := io.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	response.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	interaction := CassetteInteraction{
+		OperationID: call.OperationID,
+		Request: CassetteRequest{
+			Method:  call.Request.Method,
+			URL:     cassette.redactor.redactURL(call.Request.URL.RequestURI()),
+			Headers: cassette.redactor.redactHeader(call.Request.Header),
+			Body:    cassette.scrubBody(call.Request.Header, requestBody),
+		},
+		Response: CassetteResponse{
+			StatusCode: response.StatusCode,
+			Headers:    cassette.redactor.redactHeader(response.Header),
+			Body:       cassette.scrubBody(response.Header, responseBody),
+		},
+	}
+	// The bodies are stored decoded, so the headers that describe their encoded form are dropped.
+	for _, header := range []http.Header{interaction.Request.Headers, interaction.Response.Headers} {
+		header.Del("Content-Encoding")
+		header.Del("Content-Length")
+	}
+
+	cassette.mutex.Lock()
+	cassette.interactions = append(cassette.interactions, interaction)
+	cassette.mutex.Unlock()
+	return response, nil
+}
+
+// scrubBody returns the body "data" with its secrets scrubbed. Compressed bodies are decompressed, and
+// binary bodies are omitted.
+func (cassette *Cassette) scrubBody(header http.Header, data []byte) string {
+	if len(data) == 0 {
+		return ""
+	}
+	if header.Get("Content-Encoding") == "gzip" {
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return ""
+		}
+		if data, err = io.ReadAll(reader); err != nil {
+			return ""
+		}
+	}
+	mediaType, _, _ := mime.ParseMediaType(header.Get("Content-Type"))
+	switch {
+	case strings.HasSuffix(mediaType, "json"):
+		if scrubbed, err := cassette.redactor.redactJSON(data); err == nil {
+			return string(scrubbed)
+		}
+		return ""
+	case strings.HasPrefix(mediaType, "text/"):
+		return string(data)
+	default:
+		return ""
+	}
+}
+
+// replay answers the request of "call" with the first unused interaction that matches it.
+func (cassette *Cassette) replay(call *Call) (*http.Response, error) {
+	requestURI := cassette.redactor.redactURL(call.Request.URL.RequestURI())
+
+	cassette.mutex.Lock()
+	defer cassette.mutex.Unlock()
+	for i, interaction := range cassette.interactions {
+		if cassette.used[i] || interaction.Request.Method != call.Request.Method || interaction.Request.URL != requestURI {
+			continue
+		}
+		cassette.used[i] = true
+
+		header := interaction.Response.Headers.Clone()
+		if header == nil {
+			header = http.Header{}
+		}
+		header.Set("Content-Length", strconv.Itoa(len(interaction.Response.Body)))
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       call.Request,
+		}, nil
+	}
+	return nil, &CassetteMismatchError{Method: call.Request.Method, URL: requestURI}
+}
+
+// ErrCassetteMismatch is matched by the CassetteMismatchError returned for requests that have no recorded interaction.
+var ErrCassetteMismatch = errors.New("no recorded interaction matches the request")
+
+// CassetteMismatchError : The error returned in replay mode for a request that has no unused recorded interaction.
+type CassetteMismatchError struct {
+	Method string
+	URL    string
+}
+
+func (err *CassetteMismatchError) Error() string {
+	return fmt.Sprintf("%s: %s %s", ErrCassetteMismatch.Error(), err.Method, err.URL)
+}
+
+// Is returns true if "target" is ErrCassetteMismatch.
+func (err *CassetteMismatchError) Is(target error) bool {
+	return target == ErrCassetteMismatch
+}
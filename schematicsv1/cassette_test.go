@@ -0,0 +1,173 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Cassette`, func() {
+	var testServer *httptest.Server
+	var dir string
+	var requests int
+
+	BeforeEach(func() {
+		requests = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			requests++
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprintf(res, `{"id": "job-%d", "inputs": [{"name": "password", "value": "response-secret", "metadata": {"secure": true}}]}`, requests)
+		}))
+
+		var err error
+		dir, err = os.MkdirTemp("", "cassette")
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+		os.RemoveAll(dir)
+	})
+
+	// newService returns a service for "url" that uses "cassette".
+	newService := func(url string, cassette *schematicsv1.Cassette) *schematicsv1.SchematicsV1 {
+		schematicsService, serviceErr := schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			URL:           url,
+			Authenticator: &core.BearerTokenAuthenticator{BearerToken: "bearer-secret"},
+		})
+		Expect(serviceErr).To(BeNil())
+		schematicsService.UseCassette(cassette)
+		return schematicsService
+	}
+
+	It(`Record and replay interactions`, func() {
+		path := filepath.Join(dir, "jobs.json")
+		recorder, err := schematicsv1.OpenCassette(path, schematicsv1.CassetteRecord, &schematicsv1.CassetteOptions{RedactFields: []string{"command_parameter"}})
+		Expect(err).To(BeNil())
+		schematicsService := newService(testServer.URL, recorder)
+
+		createJobOptions := schematicsService.NewCreateJobOptions("refresh-secret")
+		createJobOptions.SetCommandParameter("parameter-secret")
+		recordedJob, _, operationErr := schematicsService.CreateJob(createJobOptions)
+		Expect(operationErr).To(BeNil())
+		_, _, operationErr = schematicsService.GetJob(schematicsService.NewGetJobOptions("job-1"))
+		Expect(operationErr).To(BeNil())
+		Expect(recorder.Save()).To(Succeed())
+
+		data, err := os.ReadFile(path)
+		Expect(err).To(BeNil())
+		for _, secret := range []string{"bearer-secret", "refresh-secret", "parameter-secret", "response-secret"} {
+			Expect(string(data)).ToNot(ContainSubstring(secret))
+		}
+		Expect(recorder.Interactions()).To(HaveLen(2))
+		Expect(recorder.Interactions()[0].OperationID).To(Equal("CreateJob"))
+		Expect(recorder.Interactions()[1].Request.URL).To(Equal("/v2/jobs/job-1"))
+
+		player, err := schematicsv1.OpenCassette(path, schematicsv1.CassetteReplay, nil)
+		Expect(err).To(BeNil())
+		schematicsService = newService("https://schematics.example.com", player)
+
+		replayedJob, response, operationErr := schematicsService.CreateJob(createJobOptions)
+		Expect(operationErr).To(BeNil())
+		Expect(response.StatusCode).To(Equal(200))
+		Expect(*replayedJob.ID).To(Equal(*recordedJob.ID))
+		Expect(*replayedJob.Inputs[0].Value).To(Equal(schematicsv1.RedactedValue))
+		job, _, operationErr := schematicsService.GetJob(schematicsService.NewGetJobOptions("job-1"))
+		Expect(operationErr).To(BeNil())
+		Expect(*job.ID).To(Equal("job-2"))
+		Expect(requests).To(Equal(2))
+
+		_, _, operationErr = schematicsService.GetJob(schematicsService.NewGetJobOptions("job-1"))
+		Expect(errors.Is(operationErr, schematicsv1.ErrCassetteMismatch)).To(BeTrue())
+	})
+	It(`Replay a gzip response with its decoded body`, func() {
+		gzipServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			res.Header().Set("Content-Encoding", "gzip")
+			res.WriteHeader(200)
+			writer := gzip.NewWriter(res)
+			fmt.Fprint(writer, `{"id": "job-gzip"}`)
+			writer.Close()
+		}))
+		defer gzipServer.Close()
+
+		// The service methods do not decode responses, so the test decodes them in front of the cassette, as a
+		// client that requests compressed responses would.
+		useDecoder := func(schematicsService *schematicsv1.SchematicsV1, cassette *schematicsv1.Cassette) {
+			schematicsService.Use(func(call *schematicsv1.Call, next schematicsv1.Handler) (*http.Response, error) {
+				call.Request.Header.Set("Accept-Encoding", "gzip")
+				response, err := next(call)
+				if err != nil || response.Header.Get("Content-Encoding") != "gzip" {
+					return response, err
+				}
+				reader, err := gzip.NewReader(response.Body)
+				if err != nil {
+					return nil, err
+				}
+				response.Body = io.NopCloser(reader)
+				response.Header.Del("Content-Encoding")
+				response.ContentLength = -1
+				return response, nil
+			})
+			schematicsService.UseCassette(cassette)
+		}
+
+		path := filepath.Join(dir, "gzip.json")
+		recorder, err := schematicsv1.OpenCassette(path, schematicsv1.CassetteRecord, nil)
+		Expect(err).To(BeNil())
+		schematicsService, serviceErr := schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			URL:           gzipServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		useDecoder(schematicsService, recorder)
+		recordedJob, _, operationErr := schematicsService.GetJob(schematicsService.NewGetJobOptions("job-gzip"))
+		Expect(operationErr).To(BeNil())
+		Expect(*recordedJob.ID).To(Equal("job-gzip"))
+		Expect(recorder.Save()).To(Succeed())
+		Expect(recorder.Interactions()[0].Response.Headers.Get("Content-Encoding")).To(BeEmpty())
+		Expect(recorder.Interactions()[0].Response.Body).To(Equal(`{"id":"job-gzip"}`))
+
+		player, err := schematicsv1.OpenCassette(path, schematicsv1.CassetteReplay, nil)
+		Expect(err).To(BeNil())
+		schematicsService, serviceErr = schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			URL:           "https://schematics.example.com",
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		useDecoder(schematicsService, player)
+		replayedJob, _, operationErr := schematicsService.GetJob(schematicsService.NewGetJobOptions("job-gzip"))
+		Expect(operationErr).To(BeNil())
+		Expect(*replayedJob.ID).To(Equal("job-gzip"))
+	})
+	It(`Fail to replay a missing cassette`, func() {
+		_, err := schematicsv1.OpenCassette(filepath.Join(dir, "missing.json"), schematicsv1.CassetteReplay, nil)
+		Expect(errors.Is(err, os.ErrNotExist)).To(BeTrue())
+	})
+})
@@ -0,0 +1,335 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Default settings of CircuitBreaker.
+const (
+	DefaultCircuitFailureThreshold    = 5
+	DefaultCircuitOpenTimeout         = 30 * time.Second
+	DefaultCircuitHalfOpenMaxRequests = 1
+)
+
+// ErrCircuitOpen is matched by the CircuitOpenError returned for requests that were rejected by an open
+// circuit breaker.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitOpenError : The error returned for a request that was rejected by an open circuit breaker,
+// without being sent. Use errors.Is(err, ErrCircuitOpen) to detect it.
+type CircuitOpenError struct {
+	// The region and operation group of the circuit.
+	Region string
+	Group  string
+
+	// The time at which the circuit transitions to half-open and lets a probe request through.
+	Until time.Time
+}
+
+func (err *CircuitOpenError) Error() string {
+	return fmt.Sprintf("circuit breaker for region '%s' and operation group '%s' is open until %s",
+		err.Region, err.Group, err.Until.Format(time.RFC3339))
+}
+
+// Is returns true if "target" is ErrCircuitOpen.
+func (err *CircuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
+
+// CircuitState : The state of a circuit.
+type CircuitState int
+
+// The states of a circuit.
+const (
+	// CircuitClosed : Requests are sent, and consecutive failures are counted.
+	CircuitClosed CircuitState = iota
+
+	// CircuitOpen : Requests are rejected with a CircuitOpenError.
+	CircuitOpen
+
+	// CircuitHalfOpen : A limited number of probe requests are sent to decide whether to close the circuit again.
+	CircuitHalfOpen
+)
+
+func (state CircuitState) String() string {
+	switch state {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return fmt.Sprintf("CircuitState(%d)", int(state))
+	}
+}
+
+// CircuitStateChange : A transition of a circuit from one state to another.
+type CircuitStateChange struct {
+	Region string
+	Group  string
+	From   CircuitState
+	To     CircuitState
+}
+
+// CircuitBreakerOptions : The options for NewCircuitBreaker.
+type CircuitBreakerOptions struct {
+	// The number of consecutive failures that open a closed circuit; DefaultCircuitFailureThreshold if 0.
+	FailureThreshold int
+
+	// The time an open circuit waits before it transitions to half-open; DefaultCircuitOpenTimeout if 0.
+	OpenTimeout time.Duration
+
+	// The number of concurrent probe requests sent while a circuit is half-open;
+	// DefaultCircuitHalfOpenMaxRequests if 0.
+	HalfOpenMaxRequests int
+
+	// The function that returns the operation group of a call; OperationGroup if nil.
+	Group func(call *Call) string
+
+	// The function that decides whether the outcome of a request is a failure; IsCircuitFailure if nil.
+	IsFailure func(response *http.Response, err error) bool
+
+	// The function called whenever a circuit changes state. It is called synchronously by the request that caused
+	// the change, and must not block.
+	OnStateChange func(change CircuitStateChange)
+}
+
+// CircuitBreaker : An opt-in circuit breaker for the requests sent by a SchematicsV1 instance, with a separate
+// circuit for each region and operation group.
+//
+// A circuit opens after a number of consecutive failed requests. While it is open, requests are rejected
+// immediately with a CircuitOpenError. After a timeout, the circuit becomes half-open and lets a limited number of
+// probe requests through: the first successful probe closes the circuit, and a failed probe opens it again.
+type CircuitBreaker struct {
+	failureThreshold    int
+	openTimeout         time.Duration
+	halfOpenMaxRequests int
+	group               func(call *Call) string
+	isFailure           func(response *http.Response, err error) bool
+	onStateChange       func(change CircuitStateChange)
+
+	mutex    sync.Mutex
+	circuits map[[2]string]*circuit
+}
+
+// circuit holds the state of the circuit of a region and operation group.
+type circuit struct {
+	state     CircuitState
+	failures  int
+	openUntil time.Time
+	probes    int
+}
+
+// NewCircuitBreaker constructs a CircuitBreaker. Install it with SchematicsV1.Use(breaker.Interceptor()), or use
+// SchematicsV1.EnableCircuitBreaker.
+func NewCircuitBreaker(options *CircuitBreakerOptions) *CircuitBreaker {
+	if options == nil {
+		options = &CircuitBreakerOptions{}
+	}
+	breaker := &CircuitBreaker{
+		failureThreshold:    options.FailureThreshold,
+		openTimeout:         options.OpenTimeout,
+		halfOpenMaxRequests: options.HalfOpenMaxRequests,
+		group:               options.Group,
+		isFailure:           options.IsFailure,
+		onStateChange:       options.OnStateChange,
+		circuits:            make(map[[2]string]*circuit),
+	}
+	if breaker.failureThreshold <= 0 {
+		breaker.failureThreshold = DefaultCircuitFailureThreshold
+	}
+	if breaker.openTimeout <= 0 {
+		breaker.openTimeout = DefaultCircuitOpenTimeout
+	}
+	if breaker.halfOpenMaxRequests <= 0 {
+		breaker.halfOpenMaxRequests = DefaultCircuitHalfOpenMaxRequests
+	}
+	if breaker.group == nil {
+		breaker.group = OperationGroup
+	}
+	if breaker.isFailure == nil {
+		breaker.isFailure = IsCircuitFailure
+	}
+	return breaker
+}
+
+// EnableCircuitBreaker installs a CircuitBreaker constructed with "options" in the interceptor chain of the instance
+// and returns it. The circuit breaker is shared with the clones of the instance.
+//
+// An operation whose request is rejected by an open circuit fails immediately with a CircuitOpenError, including
+// when automatic retries are enabled: its remaining retries are canceled.
+func (schematics *SchematicsV1) EnableCircuitBreaker(options *CircuitBreakerOptions) *CircuitBreaker {
+	breaker := NewCircuitBreaker(options)
+	schematics.Use(breaker.Interceptor())
+	return breaker
+}
+
+// OperationGroup returns the default operation group of a call: the first collection in the request path,
+// e.g. "workspaces", "actions" or "jobs".
+func OperationGroup(call *Call) string {
+	segments := strings.Split(strings.Trim(call.Request.URL.Path, "/"), "/")
+	for _, segment := range segments {
+		if _, ok := resourceTypes[segment]; ok {
+			return segment
+		}
+	}
+	if len(segments) > 1 {
+		return segments[1]
+	}
+	return segments[0]
+}
+
+// IsCircuitFailure returns true if the outcome of a request indicates that the service is unavailable: an error
+// other than a canceled context, or a status code of 5xx other than 501 Not Implemented.
+func IsCircuitFailure(response *http.Response, err error) bool {
+	if err != nil {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, ErrCircuitOpen)
+	}
+	return response.StatusCode >= 500 && response.StatusCode != http.StatusNotImplemented
+}
+
+// RegionForURL returns the region whose public or private service URL has the host of "serviceURL", or the
+// host itself if it does not belong to a known region.
+func RegionForURL(serviceURL *url.URL) string {
+	for region, endpoints := range regionalEndpoints {
+		if serviceURL.Host == strings.TrimPrefix(endpoints.public, "https://") ||
+			serviceURL.Host == strings.TrimPrefix(endpoints.private, "https://") {
+			return region
+		}
+	}
+	return serviceURL.Host
+}
+
+// State returns the state of the circuit of a region and operation group.
+func (breaker *CircuitBreaker) State(region string, group string) CircuitState {
+	breaker.mutex.Lock()
+	defer breaker.mutex.Unlock()
+	if circuit, ok := breaker.circuits[[2]string{region, group}]; ok {
+		if circuit.state == CircuitOpen && !time.Now().Before(circuit.openUntil) {
+			return CircuitHalfOpen
+		}
+		return circuit.state
+	}
+	return CircuitClosed
+}
+
+// Interceptor returns the interceptor that applies the circuit breaker to the requests of a SchematicsV1 instance.
+func (breaker *CircuitBreaker) Interceptor() Interceptor {
+	return func(call *Call, next Handler) (*http.Response, error) {
+		region, group := RegionForURL(call.Request.URL), breaker.group(call)
+		probe, err := breaker.allow(region, group)
+		if err != nil {
+			// The open circuit rejects the retries of the operation too, which therefore fails without them.
+			if call.Operation != nil {
+				call.Operation.abort(err)
+			}
+			return nil, err
+		}
+		response, err := next(call)
+		breaker.record(region, group, probe, breaker.isFailure(response, err))
+		return response, err
+	}
+}
+
+// allow decides whether a request may be sent through the circuit of a region and operation group. It returns
+// a CircuitOpenError if not, and whether the request is a probe of a half-open circuit otherwise.
+func (breaker *CircuitBreaker) allow(region string, group string) (probe bool, err error) {
+	var changes []CircuitStateChange
+	breaker.mutex.Lock()
+
+	key := [2]string{region, group}
+	c, ok := breaker.circuits[key]
+	if !ok {
+		c = &circuit{}
+		breaker.circuits[key] = c
+	}
+	if c.state == CircuitOpen && !time.Now().Before(c.openUntil) {
+		changes = append(changes, breaker.transition(region, group, c, CircuitHalfOpen))
+	}
+	switch {
+	case c.state == CircuitOpen, c.state == CircuitHalfOpen && c.probes >= breaker.halfOpenMaxRequests:
+		err = &CircuitOpenError{Region: region, Group: group, Until: c.openUntil}
+	case c.state == CircuitHalfOpen:
+		c.probes++
+		probe = true
+	}
+
+	breaker.mutex.Unlock()
+	breaker.notify(changes)
+	return
+}
+
+// record updates the circuit of a region and operation group with the outcome of a request.
+func (breaker *CircuitBreaker) record(region string, group string, probe bool, failed bool) {
+	var changes []CircuitStateChange
+	breaker.mutex.Lock()
+
+	c := breaker.circuits[[2]string{region, group}]
+	switch {
+	case probe:
+		c.probes--
+		if c.state != CircuitHalfOpen {
+			break
+		}
+		if failed {
+			changes = append(changes, breaker.transition(region, group, c, CircuitOpen))
+		} else {
+			changes = append(changes, breaker.transition(region, group, c, CircuitClosed))
+		}
+	case c.state == CircuitClosed && failed:
+		c.failures++
+		if c.failures >= breaker.failureThreshold {
+			changes = append(changes, breaker.transition(region, group, c, CircuitOpen))
+		}
+	case c.state == CircuitClosed:
+		c.failures = 0
+	}
+
+	breaker.mutex.Unlock()
+	breaker.notify(changes)
+}
+
+// transition changes the state of a circuit and returns the change. The caller must hold the mutex.
+func (breaker *CircuitBreaker) transition(region string, group string, c *circuit, state CircuitState) CircuitStateChange {
+	from := c.state
+	c.state = state
+	c.failures = 0
+	if state == CircuitOpen {
+		c.openUntil = time.Now().Add(breaker.openTimeout)
+	}
+	return CircuitStateChange{Region: region, Group: group, From: from, To: state}
+}
+
+// notify calls the state-change callback for each of "changes".
+func (breaker *CircuitBreaker) notify(changes []CircuitStateChange) {
+	if breaker.onStateChange == nil {
+		return
+	}
+	for _, change := range changes {
+		breaker.onStateChange(change)
+	}
+}
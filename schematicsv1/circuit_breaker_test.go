@@ -0,0 +1,159 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CircuitBreaker`, func() {
+	var testServer *httptest.Server
+	var schematicsService *schematicsv1.SchematicsV1
+	var mutex sync.Mutex
+	var failures int
+	var requests int
+
+	BeforeEach(func() {
+		failures = 0
+		requests = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			requests++
+			res.Header().Set("Content-type", "application/json")
+			if failures > 0 {
+				failures--
+				res.WriteHeader(503)
+				res.Write([]byte(`{"message": "unavailable"}`))
+				return
+			}
+			res.WriteHeader(200)
+			res.Write([]byte(`{"id": "testString"}`))
+		}))
+
+		var serviceErr error
+		schematicsService, serviceErr = schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Open, half-open and close the circuit`, func() {
+		var changes []schematicsv1.CircuitStateChange
+		breaker := schematicsService.EnableCircuitBreaker(&schematicsv1.CircuitBreakerOptions{
+			FailureThreshold: 2,
+			OpenTimeout:      100 * time.Millisecond,
+			OnStateChange: func(change schematicsv1.CircuitStateChange) {
+				changes = append(changes, change)
+			},
+		})
+		region := schematicsv1.RegionForURL(&url.URL{Host: testServer.Listener.Addr().String()})
+		failures = 2
+
+		for i := 0; i < 2; i++ {
+			_, _, operationErr := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("testString"))
+			Expect(operationErr).ToNot(BeNil())
+		}
+		Expect(breaker.State(region, "workspaces")).To(Equal(schematicsv1.CircuitOpen))
+
+		_, _, operationErr := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("testString"))
+		Expect(errors.Is(operationErr, schematicsv1.ErrCircuitOpen)).To(BeTrue())
+		var circuitOpenErr *schematicsv1.CircuitOpenError
+		Expect(errors.As(operationErr, &circuitOpenErr)).To(BeTrue())
+		Expect(circuitOpenErr.Region).To(Equal(region))
+		Expect(circuitOpenErr.Group).To(Equal("workspaces"))
+		Expect(requests).To(Equal(2))
+
+		// Other operation groups have their own circuit.
+		_, _, operationErr = schematicsService.GetJob(schematicsService.NewGetJobOptions("testString"))
+		Expect(operationErr).To(BeNil())
+
+		time.Sleep(100 * time.Millisecond)
+		Expect(breaker.State(region, "workspaces")).To(Equal(schematicsv1.CircuitHalfOpen))
+		_, _, operationErr = schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("testString"))
+		Expect(operationErr).To(BeNil())
+		Expect(breaker.State(region, "workspaces")).To(Equal(schematicsv1.CircuitClosed))
+
+		Expect(changes).To(Equal([]schematicsv1.CircuitStateChange{
+			{Region: region, Group: "workspaces", From: schematicsv1.CircuitClosed, To: schematicsv1.CircuitOpen},
+			{Region: region, Group: "workspaces", From: schematicsv1.CircuitOpen, To: schematicsv1.CircuitHalfOpen},
+			{Region: region, Group: "workspaces", From: schematicsv1.CircuitHalfOpen, To: schematicsv1.CircuitClosed},
+		}))
+	})
+	It(`Reopen the circuit when a probe fails`, func() {
+		breaker := schematicsService.EnableCircuitBreaker(&schematicsv1.CircuitBreakerOptions{
+			FailureThreshold: 1,
+			OpenTimeout:      50 * time.Millisecond,
+		})
+		region := schematicsv1.RegionForURL(&url.URL{Host: testServer.Listener.Addr().String()})
+		failures = 2
+
+		_, _, operationErr := schematicsService.ListActions(schematicsService.NewListActionsOptions())
+		Expect(operationErr).ToNot(BeNil())
+		time.Sleep(50 * time.Millisecond)
+		_, _, operationErr = schematicsService.ListActions(schematicsService.NewListActionsOptions())
+		Expect(errors.Is(operationErr, schematicsv1.ErrCircuitOpen)).To(BeFalse())
+		Expect(breaker.State(region, "actions")).To(Equal(schematicsv1.CircuitOpen))
+		Expect(requests).To(Equal(2))
+	})
+	It(`Fail fast without retrying when the circuit is open and retries are enabled`, func() {
+		schematicsService.EnableRetries(3, 500*time.Millisecond)
+		breaker := schematicsService.EnableCircuitBreaker(&schematicsv1.CircuitBreakerOptions{FailureThreshold: 1})
+		region := schematicsv1.RegionForURL(&url.URL{Host: testServer.Listener.Addr().String()})
+		failures = 10
+
+		// The first attempt opens the circuit, which rejects the first retry and ends the operation.
+		_, _, operationErr := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("testString"))
+		Expect(errors.Is(operationErr, schematicsv1.ErrCircuitOpen)).To(BeTrue())
+		Expect(breaker.State(region, "workspaces")).To(Equal(schematicsv1.CircuitOpen))
+		Expect(requests).To(Equal(1))
+
+		start := time.Now()
+		_, response, operationErr := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("testString"))
+		Expect(errors.Is(operationErr, schematicsv1.ErrCircuitOpen)).To(BeTrue())
+		Expect(response).To(BeNil())
+		Expect(time.Since(start)).To(BeNumerically("<", 100*time.Millisecond))
+		Expect(requests).To(Equal(1))
+	})
+	It(`Ignore client errors`, func() {
+		Expect(schematicsv1.IsCircuitFailure(&http.Response{StatusCode: 404}, nil)).To(BeFalse())
+		Expect(schematicsv1.IsCircuitFailure(&http.Response{StatusCode: 501}, nil)).To(BeFalse())
+		Expect(schematicsv1.IsCircuitFailure(&http.Response{StatusCode: 502}, nil)).To(BeTrue())
+		Expect(schematicsv1.IsCircuitFailure(nil, errors.New("connection refused"))).To(BeTrue())
+	})
+	It(`Identify regions by service URL`, func() {
+		serviceURL, _ := url.Parse("https://private-eu-de.schematics.cloud.ibm.com/v1/workspaces")
+		Expect(schematicsv1.RegionForURL(serviceURL)).To(Equal("eu-de"))
+		serviceURL, _ = url.Parse("https://schematics.example.com/v1/workspaces")
+		Expect(schematicsv1.RegionForURL(serviceURL)).To(Equal("schematics.example.com"))
+	})
+})
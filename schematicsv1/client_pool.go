@@ -0,0 +1,307 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultClientIdleTimeout : The default time after which the unused client of a tenant is evicted from a
+// ClientPool.
+const DefaultClientIdleTimeout = 10 * time.Minute
+
+// ErrClientPoolClosed is returned by the methods of a ClientPool that was shut down.
+var ErrClientPoolClosed = errors.New("client pool is shut down")
+
+// TenantConfig : The configuration of the client of a tenant of a ClientPool.
+type TenantConfig struct {
+	// The authenticator of the tenant. If nil, the authenticator of the template is used.
+	Authenticator core.Authenticator
+
+	// The service URL of the tenant. If empty, the URL of Region is used, if set, else the URL of the template.
+	URL string
+
+	// The region whose public endpoint is used by the tenant, if URL is empty.
+	Region string
+
+	// The maximum number of retries of the requests of the tenant. If zero, the retry settings of the template are
+	// used; if negative, retries are disabled.
+	MaxRetries int
+
+	// The maximum interval between retries, if MaxRetries is positive; the core default if zero.
+	MaxRetryInterval time.Duration
+}
+
+// ClientPoolOptions : The options for NewClientPool.
+type ClientPoolOptions struct {
+	// The instance cloned to build the client of each tenant. Its interceptor chain and HTTP client are shared by
+	// the clients of all tenants.
+	Template *SchematicsV1 `validate:"required"`
+
+	// The function that returns the configuration of the client of a tenant, called when the client is first
+	// needed and after it was evicted.
+	Configure func(tenant string) (*TenantConfig, error) `validate:"required"`
+
+	// The time after which the client of a tenant that is not used is evicted; DefaultClientIdleTimeout if zero.
+	IdleTimeout time.Duration
+}
+
+// ClientPool : A concurrency-safe set of SchematicsV1 instances keyed by tenant, e.g. an account or a profile.
+//
+// The client of a tenant is cloned from a template instance when first requested, configured with the
+// authenticator, URL and retry settings of the tenant, and evicted once it has not been used for the idle timeout
+// of the pool. Concurrent requests for the client of a new tenant share a single call to the Configure function.
+type ClientPool struct {
+	template    *SchematicsV1
+	configure   func(tenant string) (*TenantConfig, error)
+	idleTimeout time.Duration
+	now         func() time.Time
+
+	mutex   sync.Mutex
+	clients map[string]*pooledClient
+	closed  bool
+	active  sync.WaitGroup
+	stop    chan struct{}
+	stopped chan struct{}
+}
+
+// pooledClient is the client of a tenant in a ClientPool.
+type pooledClient struct {
+	// ready is closed once client and err are set.
+	ready  chan struct{}
+	client *SchematicsV1
+	err    error
+
+	// The number of Do calls using the client, and the time at which it was last used.
+	inUse    int
+	lastUsed time.Time
+}
+
+// NewClientPool constructs a ClientPool and starts the eviction of its idle clients. Call Shutdown to stop it.
+func NewClientPool(options *ClientPoolOptions) (*ClientPool, error) {
+	if err := core.ValidateNotNil(options, "options cannot be nil"); err != nil {
+		return nil, err
+	}
+	if err := core.ValidateStruct(options, "options"); err != nil {
+		return nil, err
+	}
+	pool := &ClientPool{
+		template:    options.Template,
+		configure:   options.Configure,
+		idleTimeout: options.IdleTimeout,
+		now:         time.Now,
+		clients:     make(map[string]*pooledClient),
+		stop:        make(chan struct{}),
+		stopped:     make(chan struct{}),
+	}
+	if pool.idleTimeout <= 0 {
+		pool.idleTimeout = DefaultClientIdleTimeout
+	}
+	go pool.evictIdle()
+	return pool, nil
+}
+
+// Get returns the client of "tenant", building it if needed. The client should not be kept for longer than the
+// idle timeout of the pool; use Do to make sure that it is not evicted while in use.
+func (pool *ClientPool) Get(tenant string) (*SchematicsV1, error) {
+	entry, err := pool.acquire(tenant, false)
+	if err != nil {
+		return nil, err
+	}
+	return entry.client, nil
+}
+
+// Do calls "f" with the client of "tenant", building it if needed. The client is not evicted while "f" runs, and
+// Shutdown waits for "f" to return.
+func (pool *ClientPool) Do(tenant string, f func(schematics *SchematicsV1) error) error {
+	entry, err := pool.acquire(tenant, true)
+	if err != nil {
+		return err
+	}
+	defer pool.release(entry)
+	return f(entry.client)
+}
+
+// Evict removes the client of "tenant" from the pool, if any. The next request for the client of the tenant builds
+// a new one, with a new call to the Configure function.
+func (pool *ClientPool) Evict(tenant string) {
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+	delete(pool.clients, tenant)
+}
+
+// Len returns the number of tenants whose client is in the pool.
+func (pool *ClientPool) Len() int {
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+	return len(pool.clients)
+}
+
+// Shutdown stops the pool: the clients are dropped, the eviction stops and further requests for clients fail with
+// ErrClientPoolClosed. It then waits for the running Do calls to return, or for "ctx" to be done.
+func (pool *ClientPool) Shutdown(ctx context.Context) error {
+	pool.mutex.Lock()
+	if !pool.closed {
+		pool.closed = true
+		pool.clients = make(map[string]*pooledClient)
+		close(pool.stop)
+	}
+	pool.mutex.Unlock()
+	<-pool.stopped
+
+	done := make(chan struct{})
+	go func() {
+		pool.active.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// acquire returns the ready client of "tenant", building it if needed, and marks it as in use if "use" is true.
+func (pool *ClientPool) acquire(tenant string, use bool) (*pooledClient, error) {
+	pool.mutex.Lock()
+	if pool.closed {
+		pool.mutex.Unlock()
+		return nil, ErrClientPoolClosed
+	}
+	entry, ok := pool.clients[tenant]
+	if !ok {
+		entry = &pooledClient{ready: make(chan struct{})}
+		pool.clients[tenant] = entry
+	}
+	entry.lastUsed = pool.now()
+	if use {
+		entry.inUse++
+		pool.active.Add(1)
+	}
+	pool.mutex.Unlock()
+
+	if !ok {
+		entry.client, entry.err = pool.build(tenant)
+		close(entry.ready)
+	}
+	<-entry.ready
+
+	if entry.err != nil {
+		pool.mutex.Lock()
+		// Failed clients are not kept, so that the next request tries again.
+		if pool.clients[tenant] == entry {
+			delete(pool.clients, tenant)
+		}
+		pool.mutex.Unlock()
+		if use {
+			pool.release(entry)
+		}
+		return nil, entry.err
+	}
+	return entry, nil
+}
+
+// release marks a client acquired by Do as no longer in use.
+func (pool *ClientPool) release(entry *pooledClient) {
+	pool.mutex.Lock()
+	entry.inUse--
+	entry.lastUsed = pool.now()
+	pool.mutex.Unlock()
+	pool.active.Done()
+}
+
+// build builds the client of "tenant" from the template.
+func (pool *ClientPool) build(tenant string) (*SchematicsV1, error) {
+	config, err := pool.configure(tenant)
+	if err != nil {
+		return nil, fmt.Errorf("unable to configure the client of tenant '%s': %w", tenant, err)
+	}
+	if config == nil {
+		config = &TenantConfig{}
+	}
+
+	client := pool.template.Clone()
+	if config.Authenticator != nil {
+		if err := config.Authenticator.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid authenticator for tenant '%s': %w", tenant, err)
+		}
+		client.Service.Options.Authenticator = config.Authenticator
+	}
+
+	serviceURL := config.URL
+	if serviceURL == "" && config.Region != "" {
+		if serviceURL, err = GetServiceURLForRegion(config.Region); err != nil {
+			return nil, err
+		}
+	}
+	if serviceURL != "" {
+		if err := client.SetServiceURL(serviceURL); err != nil {
+			return nil, fmt.Errorf("unable to set service URL for tenant '%s': %w", tenant, err)
+		}
+	}
+
+	// The HTTP client of the template is shared, so retries are configured on a client of the clone's own.
+	switch {
+	case config.MaxRetries > 0:
+		client.DisableRetries()
+		client.EnableRetries(config.MaxRetries, config.MaxRetryInterval)
+	case config.MaxRetries < 0:
+		client.DisableRetries()
+	}
+	return client, nil
+}
+
+// evictIdle periodically evicts the clients that were not used for the idle timeout, until the pool is shut down.
+func (pool *ClientPool) evictIdle() {
+	defer close(pool.stopped)
+	ticker := time.NewTicker(pool.idleTimeout / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-pool.stop:
+			return
+		case <-ticker.C:
+			pool.EvictIdle()
+		}
+	}
+}
+
+// EvictIdle evicts the clients that are not in use and were not used for the idle timeout of the pool. It is called
+// periodically by the pool.
+func (pool *ClientPool) EvictIdle() {
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+	deadline := pool.now().Add(-pool.idleTimeout)
+	for tenant, entry := range pool.clients {
+		select {
+		case <-entry.ready:
+		default:
+			// Clients being built are in use.
+			continue
+		}
+		if entry.inUse == 0 && entry.lastUsed.Before(deadline) {
+			delete(pool.clients, tenant)
+		}
+	}
+}
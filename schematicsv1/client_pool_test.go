@@ -0,0 +1,225 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ClientPool`, func() {
+	var testServer *httptest.Server
+	var template *schematicsv1.SchematicsV1
+	var mutex sync.Mutex
+	var authorizations []string
+	var configured atomic.Int32
+
+	BeforeEach(func() {
+		authorizations = nil
+		configured.Store(0)
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			mutex.Lock()
+			authorizations = append(authorizations, req.Header.Get("Authorization"))
+			mutex.Unlock()
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			_, _ = res.Write([]byte(`{"id": "ws-1"}`))
+		}))
+
+		var serviceErr error
+		template, serviceErr = schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	// configure configures each tenant with a bearer token named after it.
+	configure := func(tenant string) (*schematicsv1.TenantConfig, error) {
+		configured.Add(1)
+		if tenant == "unknown" {
+			return nil, errors.New("unknown tenant")
+		}
+		return &schematicsv1.TenantConfig{
+			Authenticator: &core.BearerTokenAuthenticator{BearerToken: tenant},
+			MaxRetries:    2,
+		}, nil
+	}
+
+	getWorkspace := func(schematicsService *schematicsv1.SchematicsV1) error {
+		_, _, err := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("ws-1"))
+		return err
+	}
+
+	It(`Builds and reuses a client per tenant`, func() {
+		pool, err := schematicsv1.NewClientPool(&schematicsv1.ClientPoolOptions{Template: template, Configure: configure})
+		Expect(err).To(BeNil())
+		defer pool.Shutdown(context.Background())
+
+		first, err := pool.Get("account-a")
+		Expect(err).To(BeNil())
+		again, err := pool.Get("account-a")
+		Expect(err).To(BeNil())
+		Expect(again).To(BeIdenticalTo(first))
+		second, err := pool.Get("account-b")
+		Expect(err).To(BeNil())
+		Expect(second).ToNot(BeIdenticalTo(first))
+		Expect(pool.Len()).To(Equal(2))
+		Expect(configured.Load()).To(Equal(int32(2)))
+
+		Expect(getWorkspace(first)).To(Succeed())
+		Expect(getWorkspace(second)).To(Succeed())
+		Expect(getWorkspace(template)).To(Succeed())
+		Expect(authorizations).To(Equal([]string{"Bearer account-a", "Bearer account-b", ""}))
+
+		// Retries are configured per tenant, without changing the template.
+		Expect(first.Service.Client).ToNot(BeIdenticalTo(template.Service.Client))
+		Expect(template.Service.GetHTTPClient()).To(BeIdenticalTo(first.Service.GetHTTPClient()))
+	})
+
+	It(`Configures the service URL of a tenant`, func() {
+		pool, err := schematicsv1.NewClientPool(&schematicsv1.ClientPoolOptions{
+			Template: template,
+			Configure: func(tenant string) (*schematicsv1.TenantConfig, error) {
+				if tenant == "eu" {
+					return &schematicsv1.TenantConfig{Region: "eu-de"}, nil
+				}
+				return &schematicsv1.TenantConfig{URL: "https://schematics.example.com"}, nil
+			},
+		})
+		Expect(err).To(BeNil())
+		defer pool.Shutdown(context.Background())
+
+		client, err := pool.Get("eu")
+		Expect(err).To(BeNil())
+		Expect(client.GetServiceURL()).To(Equal("https://eu-de.schematics.cloud.ibm.com"))
+		client, err = pool.Get("custom")
+		Expect(err).To(BeNil())
+		Expect(client.GetServiceURL()).To(Equal("https://schematics.example.com"))
+		Expect(template.GetServiceURL()).To(Equal(testServer.URL))
+	})
+
+	It(`Shares the configuration of a new tenant between concurrent requests`, func() {
+		pool, err := schematicsv1.NewClientPool(&schematicsv1.ClientPoolOptions{Template: template, Configure: configure})
+		Expect(err).To(BeNil())
+		defer pool.Shutdown(context.Background())
+
+		var wg sync.WaitGroup
+		clients := make([]*schematicsv1.SchematicsV1, 20)
+		for i := range clients {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				defer GinkgoRecover()
+				var getErr error
+				clients[i], getErr = pool.Get("account-a")
+				Expect(getErr).To(BeNil())
+			}(i)
+		}
+		wg.Wait()
+		Expect(configured.Load()).To(Equal(int32(1)))
+		for _, client := range clients {
+			Expect(client).To(BeIdenticalTo(clients[0]))
+		}
+	})
+
+	It(`Reports configuration errors and retries them`, func() {
+		pool, err := schematicsv1.NewClientPool(&schematicsv1.ClientPoolOptions{Template: template, Configure: configure})
+		Expect(err).To(BeNil())
+		defer pool.Shutdown(context.Background())
+
+		_, err = pool.Get("unknown")
+		Expect(err).To(MatchError(ContainSubstring("unknown tenant")))
+		_, err = pool.Get("unknown")
+		Expect(err).ToNot(BeNil())
+		Expect(configured.Load()).To(Equal(int32(2)))
+		Expect(pool.Len()).To(Equal(0))
+	})
+
+	It(`Evicts idle clients that are not in use`, func() {
+		pool, err := schematicsv1.NewClientPool(&schematicsv1.ClientPoolOptions{
+			Template:    template,
+			Configure:   configure,
+			IdleTimeout: 50 * time.Millisecond,
+		})
+		Expect(err).To(BeNil())
+		defer pool.Shutdown(context.Background())
+
+		_, err = pool.Get("idle")
+		Expect(err).To(BeNil())
+		err = pool.Do("busy", func(schematicsService *schematicsv1.SchematicsV1) error {
+			Eventually(pool.Len, time.Second, 10*time.Millisecond).Should(Equal(1))
+			return getWorkspace(schematicsService)
+		})
+		Expect(err).To(BeNil())
+		Eventually(pool.Len, time.Second, 10*time.Millisecond).Should(Equal(0))
+
+		pool.Evict("busy")
+		_, err = pool.Get("idle")
+		Expect(err).To(BeNil())
+		Expect(configured.Load()).To(Equal(int32(3)))
+	})
+
+	It(`Shuts down after the running calls`, func() {
+		pool, err := schematicsv1.NewClientPool(&schematicsv1.ClientPoolOptions{Template: template, Configure: configure})
+		Expect(err).To(BeNil())
+
+		started := make(chan struct{})
+		finish := make(chan struct{})
+		var finished atomic.Bool
+		go func() {
+			defer GinkgoRecover()
+			Expect(pool.Do("account-a", func(*schematicsv1.SchematicsV1) error {
+				close(started)
+				<-finish
+				finished.Store(true)
+				return nil
+			})).To(Succeed())
+		}()
+		<-started
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		Expect(pool.Shutdown(ctx)).To(Equal(context.DeadlineExceeded))
+		_, err = pool.Get("account-a")
+		Expect(errors.Is(err, schematicsv1.ErrClientPoolClosed)).To(BeTrue())
+
+		close(finish)
+		Expect(pool.Shutdown(context.Background())).To(Succeed())
+		Expect(finished.Load()).To(BeTrue())
+	})
+
+	It(`Requires a template and a configuration function`, func() {
+		_, err := schematicsv1.NewClientPool(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = schematicsv1.NewClientPool(&schematicsv1.ClientPoolOptions{Template: template})
+		Expect(err).ToNot(BeNil())
+	})
+})
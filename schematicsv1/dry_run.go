@@ -0,0 +1,177 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// RenderedRequest : An HTTP request rendered by a dry-run instance, as it would have been sent to the service.
+type RenderedRequest struct {
+	// The operationId of the operation that built the request, e.g. "ReplaceWorkspace".
+	OperationID string `json:"operation_id"`
+
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+	Path    string      `json:"path"`
+	Query   url.Values  `json:"query,omitempty"`
+	Headers http.Header `json:"headers,omitempty"`
+
+	// The body of the request, for JSON bodies.
+	Body json.RawMessage `json:"body,omitempty"`
+
+	// The body of the request, for other bodies such as multipart template uploads.
+	RawBody []byte `json:"raw_body,omitempty"`
+}
+
+// renderedRequestKey is the key of the request rendered by a dry-run instance in the state of its operation.
+type renderedRequestKey struct{}
+
+// GetRenderedRequest returns the request rendered by a dry-run instance from the response returned by one of its
+// operations, or nil if "response" is not the response of a dry-run instance.
+func GetRenderedRequest(response *core.DetailedResponse) *RenderedRequest {
+	if response == nil {
+		return nil
+	}
+	rendered, _ := response.Result.(*RenderedRequest)
+	return rendered
+}
+
+// DryRunOptions : The options for DryRun.
+type DryRunOptions struct {
+	// The names of additional headers, query parameters and JSON properties whose values are redacted from the
+	// rendered requests, in addition to the DefaultRedactedFields. Names are matched case-insensitively.
+	RedactFields []string
+}
+
+// DryRun returns a copy of the instance whose operations validate their options and build their HTTP request as
+// usual, but render the request instead of sending it. The operations succeed with a nil result and a synthetic
+// "200 OK" response whose Result is the *RenderedRequest. Use GetRenderedRequest to retrieve it:
+//
+//	_, response, err := schematicsService.DryRun(nil).ReplaceWorkspace(replaceWorkspaceOptions)
+//	if request := schematicsv1.GetRenderedRequest(response); request != nil {
+//		// Show the request for review
+//	}
+//
+// Invalid options are reported by the usual validation errors. Secrets are redacted from the rendered requests as
+// described for NewLoggingInterceptor, and compressed bodies are rendered decompressed. The copy does not
+// authenticate its requests nor call the token providers of the instance (see SetTokenProvider and
+// SetGitTokenProvider), to avoid calling out to IAM and to Git credential helpers, so the tokens that they would
+// supply must be set in the options. It does not run the interceptor chain of the instance nor retry.
+func (schematics *SchematicsV1) DryRun(options *DryRunOptions) *SchematicsV1 {
+	if options == nil {
+		options = &DryRunOptions{}
+	}
+	clone := schematics.Clone()
+	clone.Service.Options.Authenticator = &core.NoAuthAuthenticator{}
+	clone.tokenProvider = nil
+	clone.gitTokenProviders = nil
+	// The HTTP client is shared with the instance, so it is replaced rather than configured.
+	clone.Service.Client = &http.Client{Transport: &dryRunTransport{redactor: newRedactor(options.RedactFields)}}
+	return clone
+}
+
+// dryRunTransport is the http.RoundTripper of dry-run instances, which renders the requests instead of sending them.
+type dryRunTransport struct {
+	redactor *redactor
+}
+
+// RoundTrip renders "req" in the state of its operation, and returns an empty "200 OK" response in place of the
+// response of the service.
+func (transport *dryRunTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rendered := &RenderedRequest{
+		Method:  req.Method,
+		URL:     transport.redactor.redactURL(req.URL.String()),
+		Path:    req.URL.Path,
+		Headers: transport.redactor.redactHeader(req.Header),
+	}
+	operation := operationFromContext(req.Context())
+	if operation != nil {
+		rendered.OperationID = operation.ID
+	}
+	if query := req.URL.Query(); len(query) > 0 {
+		for name, values := range query {
+			if transport.redactor.isRedacted(name) {
+				for i := range values {
+					values[i] = RedactedValue
+				}
+			}
+		}
+		rendered.Query = query
+	}
+
+	if req.Body != nil {
+		data, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		if req.Header.Get("Content-Encoding") == "gzip" {
+			reader, err := gzip.NewReader(bytes.NewReader(data))
+			if err != nil {
+				return nil, err
+			}
+			if data, err = io.ReadAll(reader); err != nil {
+				return nil, err
+			}
+			rendered.Headers.Del("Content-Encoding")
+		}
+		if err := transport.renderBody(rendered, data); err != nil {
+			return nil, err
+		}
+	}
+	if operation != nil {
+		operation.SetValue(renderedRequestKey{}, rendered)
+	}
+	return &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     make(http.Header),
+		Body:       http.NoBody,
+		Request:    req,
+	}, nil
+}
+
+// renderBody sets the body of "rendered" to "data", redacted if it is a JSON document.
+func (transport *dryRunTransport) renderBody(rendered *RenderedRequest, data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+	mediaType, _, _ := mime.ParseMediaType(rendered.Headers.Get("Content-Type"))
+	if !strings.HasSuffix(mediaType, "json") {
+		rendered.RawBody = data
+		return nil
+	}
+	redacted, err := transport.redactor.redactJSON(data)
+	if err != nil {
+		return err
+	}
+	rendered.Body = redacted
+	return nil
+}
@@ -0,0 +1,166 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`DryRun`, func() {
+	var testServer *httptest.Server
+	var schematicsService *schematicsv1.SchematicsV1
+	var requests int
+
+	BeforeEach(func() {
+		requests = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			requests++
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			_, _ = res.Write([]byte(`"Workspace deleted"`))
+		}))
+
+		var serviceErr error
+		schematicsService, serviceErr = schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.BearerTokenAuthenticator{BearerToken: "bearer-secret"},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Renders a request instead of sending it`, func() {
+		dryRun := schematicsService.DryRun(nil)
+
+		deleteWorkspaceOptions := schematicsService.NewDeleteWorkspaceOptions("refresh-secret", "us-south.workspace.ws.1")
+		deleteWorkspaceOptions.SetDestroyResources("true")
+		result, response, err := dryRun.DeleteWorkspace(deleteWorkspaceOptions)
+		Expect(err).To(BeNil())
+		Expect(result).To(BeNil())
+		Expect(response.StatusCode).To(Equal(200))
+		Expect(requests).To(Equal(0))
+
+		request := schematicsv1.GetRenderedRequest(response)
+		Expect(request).ToNot(BeNil())
+		Expect(request.OperationID).To(Equal("DeleteWorkspace"))
+		Expect(request.Method).To(Equal(http.MethodDelete))
+		Expect(request.URL).To(Equal(testServer.URL + "/v1/workspaces/us-south.workspace.ws.1?destroy_resources=true"))
+		Expect(request.Path).To(Equal("/v1/workspaces/us-south.workspace.ws.1"))
+		Expect(request.Query.Get("destroy_resources")).To(Equal("true"))
+		Expect(request.Headers["refresh_token"]).To(Equal([]string{schematicsv1.RedactedValue}))
+		Expect(request.Headers.Get("Authorization")).To(BeEmpty())
+		Expect(request.Body).To(BeNil())
+
+		// The instance itself still sends its requests.
+		result, response, err = schematicsService.DeleteWorkspace(deleteWorkspaceOptions)
+		Expect(err).To(BeNil())
+		Expect(*result).To(Equal("Workspace deleted"))
+		Expect(schematicsv1.GetRenderedRequest(response)).To(BeNil())
+		Expect(requests).To(Equal(1))
+	})
+
+	It(`Renders redacted JSON bodies`, func() {
+		schematicsService.SetEnableGzipCompression(true)
+		dryRun := schematicsService.DryRun(&schematicsv1.DryRunOptions{RedactFields: []string{"description"}})
+
+		replaceWorkspaceOptions := schematicsService.NewReplaceWorkspaceOptions("ws-1")
+		replaceWorkspaceOptions.SetName("renamed")
+		replaceWorkspaceOptions.SetDescription("internal")
+		replaceWorkspaceOptions.SetXGithubToken("git-secret")
+		replaceWorkspaceOptions.SetTemplateData([]schematicsv1.TemplateSourceDataRequest{{
+			Variablestore: []schematicsv1.WorkspaceVariableRequest{
+				{Name: core.StringPtr("region"), Value: core.StringPtr("us-south")},
+				{Name: core.StringPtr("api_key"), Value: core.StringPtr("variable-secret"), Secure: core.BoolPtr(true)},
+			},
+		}})
+		_, response, err := dryRun.ReplaceWorkspace(replaceWorkspaceOptions)
+		Expect(err).To(BeNil())
+		request := schematicsv1.GetRenderedRequest(response)
+		Expect(request).ToNot(BeNil())
+		Expect(request.Method).To(Equal(http.MethodPut))
+		Expect(request.Headers["X-Github-token"]).To(Equal([]string{schematicsv1.RedactedValue}))
+		Expect(request.Headers.Get("Content-Encoding")).To(BeEmpty())
+
+		var body map[string]interface{}
+		Expect(json.Unmarshal(request.Body, &body)).To(Succeed())
+		Expect(body["name"]).To(Equal("renamed"))
+		Expect(body["description"]).To(Equal(schematicsv1.RedactedValue))
+		variables := body["template_data"].([]interface{})[0].(map[string]interface{})["variablestore"].([]interface{})
+		Expect(variables[0].(map[string]interface{})["value"]).To(Equal("us-south"))
+		Expect(variables[1].(map[string]interface{})["value"]).To(Equal(schematicsv1.RedactedValue))
+		Expect(string(request.Body)).ToNot(ContainSubstring("secret"))
+	})
+
+	It(`Validates the options`, func() {
+		_, response, err := schematicsService.DryRun(nil).DeleteWorkspace(&schematicsv1.DeleteWorkspaceOptions{})
+		Expect(err).ToNot(BeNil())
+		Expect(schematicsv1.GetRenderedRequest(response)).To(BeNil())
+	})
+
+	It(`Does not call the token providers of the instance`, func() {
+		schematicsService.SetTokenProvider(&staticTokenProvider{err: errors.New("the token provider was called")})
+		gitTokenProvider := &hostGitTokenProvider{token: "git-secret"}
+		schematicsService.SetGitTokenProvider("github.com", gitTokenProvider)
+		dryRun := schematicsService.DryRun(nil)
+
+		_, response, err := dryRun.ApplyWorkspaceCommand(schematicsService.NewApplyWorkspaceCommandOptions("ws-1", "refresh-secret"))
+		Expect(err).To(BeNil())
+		Expect(schematicsv1.GetRenderedRequest(response)).ToNot(BeNil())
+		Expect(schematicsv1.GetRenderedRequest(response).Headers.Get("delegated_token")).To(BeEmpty())
+
+		createWorkspaceOptions := schematicsService.NewCreateWorkspaceOptions()
+		createWorkspaceOptions.SetTemplateRepo(&schematicsv1.TemplateRepoRequest{URL: core.StringPtr("https://github.com/org/repo")})
+		_, response, err = dryRun.CreateWorkspace(createWorkspaceOptions)
+		Expect(err).To(BeNil())
+		Expect(string(schematicsv1.GetRenderedRequest(response).Body)).ToNot(ContainSubstring("git-secret"))
+		Expect(gitTokenProvider.repoURLs).To(BeEmpty())
+		Expect(requests).To(Equal(0))
+
+		// The instance itself still uses its providers.
+		_, _, err = schematicsService.ApplyWorkspaceCommand(schematicsService.NewApplyWorkspaceCommandOptions("ws-1", "refresh-secret"))
+		Expect(err).ToNot(BeNil())
+	})
+
+	It(`Does not affect the interceptors and retries of the instance`, func() {
+		intercepted := 0
+		schematicsService.EnableRetries(2, 0)
+		schematicsService.Use(func(call *schematicsv1.Call, next schematicsv1.Handler) (*http.Response, error) {
+			intercepted++
+			return next(call)
+		})
+
+		_, response, err := schematicsService.DryRun(nil).GetWorkspace(schematicsService.NewGetWorkspaceOptions("ws-1"))
+		Expect(err).To(BeNil())
+		Expect(schematicsv1.GetRenderedRequest(response)).ToNot(BeNil())
+		Expect(intercepted).To(Equal(0))
+
+		_, _, err = schematicsService.DeleteWorkspace(schematicsService.NewDeleteWorkspaceOptions("token", "ws-1"))
+		Expect(err).To(BeNil())
+		Expect(intercepted).To(Equal(1))
+		Expect(requests).To(Equal(1))
+	})
+})
@@ -0,0 +1,181 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// SchematicsError : The error returned by an operation when the service responds with an unsuccessful status code.
+// Use errors.As to retrieve it from the error returned by an operation, or one of the Is* helpers to classify it.
+type SchematicsError struct {
+	// The HTTP status code of the response.
+	StatusCode int
+
+	// The Schematics message ID or error code that identifies the error (e.g. "M1004").
+	Code string
+
+	// The error message.
+	Message string
+
+	// The ID of the request, as reported by the service.
+	RequestID string
+
+	// The transaction ID of the request, which can be used to correlate it with IBM support.
+	TransactionID string
+
+	// A link to more information about the error.
+	HelpLink string
+
+	// The detailed response returned by the operation.
+	Response *core.DetailedResponse
+
+	err error
+}
+
+// Error returns the error message.
+func (schematicsError *SchematicsError) Error() string {
+	return schematicsError.err.Error()
+}
+
+// Unwrap returns the error returned by the core request processing.
+func (schematicsError *SchematicsError) Unwrap() error {
+	return schematicsError.err
+}
+
+// newSchematicsError returns a SchematicsError describing "err" if "response" holds an unsuccessful status code,
+// otherwise "err" is returned unchanged.
+func newSchematicsError(err error, response *core.DetailedResponse) error {
+	if err == nil || response == nil || (response.StatusCode >= 200 && response.StatusCode < 300) {
+		return err
+	}
+
+	schematicsError := &SchematicsError{
+		StatusCode:    response.StatusCode,
+		Message:       err.Error(),
+		RequestID:     response.Headers.Get("X-Request-Id"),
+		TransactionID: transactionID(response.Headers),
+		Response:      response,
+		err:           err,
+	}
+
+	body, ok := response.Result.(map[string]interface{})
+	if !ok {
+		return schematicsError
+	}
+
+	// Schematics reports errors as {"requestid", "messageid", "message", "statuscode"}, while the
+	// IBM Cloud API handbook format is {"trace", "errors": [{"code", "message", "more_info"}]}.
+	if errorList, ok := body["errors"].([]interface{}); ok && len(errorList) > 0 {
+		if firstError, ok := errorList[0].(map[string]interface{}); ok {
+			schematicsError.Code = stringField(firstError, "code")
+			schematicsError.HelpLink = stringField(firstError, "more_info")
+		}
+	}
+	if schematicsError.Code == "" {
+		schematicsError.Code = stringField(body, "messageid", "code", "error_code")
+	}
+	if schematicsError.HelpLink == "" {
+		schematicsError.HelpLink = stringField(body, "more_info", "help_link")
+	}
+	if requestID := stringField(body, "requestid", "trace"); requestID != "" {
+		schematicsError.RequestID = requestID
+	}
+	return schematicsError
+}
+
+// stringField returns the first non-empty string value found in "m" for one of "keys".
+func stringField(m map[string]interface{}, keys ...string) string {
+	for _, key := range keys {
+		if value, ok := m[key].(string); ok && value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// asSchematicsError returns the SchematicsError in the chain of "err", or nil if there is none.
+func asSchematicsError(err error) *SchematicsError {
+	var schematicsError *SchematicsError
+	if errors.As(err, &schematicsError) {
+		return schematicsError
+	}
+	return nil
+}
+
+// IsNotFound returns true if "err" reports that the requested resource does not exist.
+func IsNotFound(err error) bool {
+	schematicsError := asSchematicsError(err)
+	return schematicsError != nil && schematicsError.StatusCode == http.StatusNotFound
+}
+
+// IsConflict returns true if "err" reports that the request conflicts with the current state of the resource.
+func IsConflict(err error) bool {
+	schematicsError := asSchematicsError(err)
+	return schematicsError != nil && schematicsError.StatusCode == http.StatusConflict
+}
+
+// The message IDs that Schematics reports in the Code of a SchematicsError for workspace states.
+const (
+	// MessageIDWorkspaceLocked : The workspace is locked by another activity.
+	MessageIDWorkspaceLocked = "M1018"
+
+	// MessageIDWorkspaceFrozen : The workspace is frozen and cannot be changed.
+	MessageIDWorkspaceFrozen = "M1127"
+)
+
+// IsWorkspaceLocked returns true if "err" reports that the workspace is locked by another activity, with the
+// status code 409 (Conflict) and the message ID MessageIDWorkspaceLocked.
+func IsWorkspaceLocked(err error) bool {
+	schematicsError := asSchematicsError(err)
+	return schematicsError != nil && schematicsError.StatusCode == http.StatusConflict &&
+		schematicsError.Code == MessageIDWorkspaceLocked
+}
+
+// IsFrozen returns true if "err" reports that the workspace is frozen and cannot be changed, with a 4xx status code
+// and the message ID MessageIDWorkspaceFrozen.
+func IsFrozen(err error) bool {
+	schematicsError := asSchematicsError(err)
+	return schematicsError != nil && schematicsError.StatusCode >= 400 && schematicsError.StatusCode < 500 &&
+		schematicsError.Code == MessageIDWorkspaceFrozen
+}
+
+// IsRateLimited returns true if "err" reports that the request was rejected because of too many requests.
+func IsRateLimited(err error) bool {
+	schematicsError := asSchematicsError(err)
+	return schematicsError != nil && schematicsError.StatusCode == http.StatusTooManyRequests
+}
+
+// IsRetryable returns true if the request that failed with "err" may succeed if it is sent again.
+// This is the case for rate limiting, server errors other than 501 (Not Implemented), and network timeouts.
+func IsRetryable(err error) bool {
+	if schematicsError := asSchematicsError(err); schematicsError != nil {
+		statusCode := schematicsError.StatusCode
+		return statusCode == http.StatusTooManyRequests ||
+			(statusCode >= 500 && statusCode <= 599 && statusCode != http.StatusNotImplemented)
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var netError net.Error
+	return errors.As(err, &netError) && netError.Timeout()
+}
@@ -0,0 +1,150 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// timeoutError is a net.Error that reports a timeout.
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+var _ = Describe(`SchematicsError`, func() {
+	var testServer *httptest.Server
+	var schematicsService *schematicsv1.SchematicsV1
+	var statusCode int
+	var body string
+
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			res.Header().Set("Transaction-Id", "transaction-1")
+			res.WriteHeader(statusCode)
+			fmt.Fprint(res, body)
+		}))
+
+		var serviceErr error
+		schematicsService, serviceErr = schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Parse a Schematics error response`, func() {
+		statusCode = 404
+		body = `{"requestid": "request-1", "timestamp": "2024-01-01T00:00:00Z", "messageid": "M1004", "message": "Workspace not found", "statuscode": 404}`
+		_, response, operationErr := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("testString"))
+		Expect(operationErr).ToNot(BeNil())
+		Expect(operationErr.Error()).To(Equal("Workspace not found"))
+
+		var schematicsError *schematicsv1.SchematicsError
+		Expect(errors.As(operationErr, &schematicsError)).To(BeTrue())
+		Expect(schematicsError.StatusCode).To(Equal(404))
+		Expect(schematicsError.Code).To(Equal("M1004"))
+		Expect(schematicsError.Message).To(Equal("Workspace not found"))
+		Expect(schematicsError.RequestID).To(Equal("request-1"))
+		Expect(schematicsError.TransactionID).To(Equal("transaction-1"))
+		Expect(schematicsError.Response).To(Equal(response))
+
+		Expect(schematicsv1.IsNotFound(operationErr)).To(BeTrue())
+		Expect(schematicsv1.IsConflict(operationErr)).To(BeFalse())
+		Expect(schematicsv1.IsRetryable(operationErr)).To(BeFalse())
+	})
+	It(`Parse an IBM Cloud error response`, func() {
+		statusCode = 503
+		body = `{"trace": "trace-1", "errors": [{"code": "service_unavailable", "message": "Try again later", "more_info": "https://cloud.ibm.com/docs/schematics"}]}`
+		_, _, operationErr := schematicsService.ListWorkspaces(&schematicsv1.ListWorkspacesOptions{})
+
+		var schematicsError *schematicsv1.SchematicsError
+		Expect(errors.As(operationErr, &schematicsError)).To(BeTrue())
+		Expect(schematicsError.Code).To(Equal("service_unavailable"))
+		Expect(schematicsError.Message).To(Equal("Try again later"))
+		Expect(schematicsError.RequestID).To(Equal("trace-1"))
+		Expect(schematicsError.HelpLink).To(Equal("https://cloud.ibm.com/docs/schematics"))
+		Expect(schematicsv1.IsRetryable(operationErr)).To(BeTrue())
+	})
+	It(`Classify a locked workspace`, func() {
+		statusCode = 409
+		body = `{"requestid": "request-2", "timestamp": "2024-01-01T00:00:00Z", "messageid": "M1018", "message": "Workspace is locked by another activity. Try again after the activity completes.", "statuscode": 409}`
+		_, _, operationErr := schematicsService.DeleteWorkspace(schematicsService.NewDeleteWorkspaceOptions("testString", "testString"))
+		Expect(schematicsv1.IsConflict(operationErr)).To(BeTrue())
+		Expect(schematicsv1.IsWorkspaceLocked(operationErr)).To(BeTrue())
+		Expect(schematicsv1.IsFrozen(operationErr)).To(BeFalse())
+	})
+	It(`Classify a frozen workspace`, func() {
+		statusCode = 400
+		body = `{"requestid": "request-3", "timestamp": "2024-01-01T00:00:00Z", "messageid": "M1127", "message": "Workspace is frozen. Unfreeze the workspace to update it.", "statuscode": 400}`
+		_, _, operationErr := schematicsService.UpdateWorkspace(schematicsService.NewUpdateWorkspaceOptions("testString"))
+		Expect(schematicsv1.IsFrozen(operationErr)).To(BeTrue())
+		Expect(schematicsv1.IsWorkspaceLocked(operationErr)).To(BeFalse())
+	})
+	It(`Do not classify errors by their message`, func() {
+		statusCode = 409
+		body = `{"requestid": "request-4", "timestamp": "2024-01-01T00:00:00Z", "messageid": "M1050", "message": "Workspace 'locked-frozen-infra' already exists in the resource group.", "statuscode": 409}`
+		_, _, operationErr := schematicsService.CreateWorkspace(schematicsService.NewCreateWorkspaceOptions())
+		Expect(schematicsv1.IsConflict(operationErr)).To(BeTrue())
+		Expect(schematicsv1.IsWorkspaceLocked(operationErr)).To(BeFalse())
+		Expect(schematicsv1.IsFrozen(operationErr)).To(BeFalse())
+
+		statusCode = 400
+		body = `{"requestid": "request-5", "timestamp": "2024-01-01T00:00:00Z", "messageid": "M1018", "message": "Workspace is locked by another activity.", "statuscode": 400}`
+		_, _, operationErr = schematicsService.UpdateWorkspace(schematicsService.NewUpdateWorkspaceOptions("testString"))
+		Expect(schematicsv1.IsWorkspaceLocked(operationErr)).To(BeFalse())
+	})
+	It(`Classify a rate limited request`, func() {
+		statusCode = 429
+		body = `{"message": "Too many requests"}`
+		_, _, operationErr := schematicsService.GetSchematicsVersion(&schematicsv1.GetSchematicsVersionOptions{})
+		Expect(schematicsv1.IsRateLimited(operationErr)).To(BeTrue())
+		Expect(schematicsv1.IsRetryable(operationErr)).To(BeTrue())
+	})
+	It(`Classify a response without a JSON body`, func() {
+		statusCode = 501
+		body = ``
+		_, _, operationErr := schematicsService.GetSchematicsVersion(&schematicsv1.GetSchematicsVersionOptions{})
+		var schematicsError *schematicsv1.SchematicsError
+		Expect(errors.As(operationErr, &schematicsError)).To(BeTrue())
+		Expect(schematicsError.StatusCode).To(Equal(501))
+		Expect(schematicsError.Code).To(BeEmpty())
+		Expect(schematicsv1.IsRetryable(operationErr)).To(BeFalse())
+	})
+	It(`Classify errors that did not come from the service`, func() {
+		Expect(schematicsv1.IsNotFound(nil)).To(BeFalse())
+		Expect(schematicsv1.IsRetryable(fmt.Errorf("request failed: %w", timeoutError{}))).To(BeTrue())
+		Expect(schematicsv1.IsRetryable(context.Canceled)).To(BeFalse())
+		Expect(schematicsv1.IsRetryable(errors.New("some error"))).To(BeFalse())
+	})
+})
@@ -0,0 +1,269 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// RegionError describes the failure of a single region within a multi-region operation.
+type RegionError struct {
+	// The region that failed.
+	Region string
+
+	// The detailed response returned by the region, if any.
+	Response *core.DetailedResponse
+
+	// The error returned by the region.
+	Err error
+}
+
+// Error returns the error message, prefixed with the region that failed.
+func (regionError *RegionError) Error() string {
+	return fmt.Sprintf("region '%s': %s", regionError.Region, regionError.Err.Error())
+}
+
+// Unwrap returns the error returned by the region.
+func (regionError *RegionError) Unwrap() error {
+	return regionError.Err
+}
+
+// RegionalWorkspace : A workspace returned by a multi-region listing, along with the region that returned it.
+type RegionalWorkspace struct {
+	// The region that returned the workspace.
+	Region string
+
+	WorkspaceResponse
+}
+
+// MultiRegionWorkspaceList : The merged result of listing workspaces in several regions.
+type MultiRegionWorkspaceList struct {
+	// The sum of the workspace counts reported by the regions that responded successfully.
+	Count int64
+
+	// The workspaces returned by the regions that responded successfully, in the order of the requested regions.
+	Workspaces []RegionalWorkspace
+
+	// The regions that failed, in the order of the requested regions.
+	Errors []*RegionError
+}
+
+// RegionalAction : An action returned by a multi-region listing, along with the region that returned it.
+type RegionalAction struct {
+	// The region that returned the action.
+	Region string
+
+	ActionLite
+}
+
+// MultiRegionActionList : The merged result of listing actions in several regions.
+type MultiRegionActionList struct {
+	// The sum of the action counts reported by the regions that responded successfully.
+	TotalCount int64
+
+	// The actions returned by the regions that responded successfully, in the order of the requested regions.
+	Actions []RegionalAction
+
+	// The regions that failed, in the order of the requested regions.
+	Errors []*RegionError
+}
+
+// RegionalJob : A job returned by a multi-region listing, along with the region that returned it.
+type RegionalJob struct {
+	// The region that returned the job.
+	Region string
+
+	JobLite
+}
+
+// MultiRegionJobList : The merged result of listing jobs in several regions.
+type MultiRegionJobList struct {
+	// The sum of the job counts reported by the regions that responded successfully.
+	TotalCount int64
+
+	// The jobs returned by the regions that responded successfully, in the order of the requested regions.
+	Jobs []RegionalJob
+
+	// The regions that failed, in the order of the requested regions.
+	Errors []*RegionError
+}
+
+// ListWorkspacesInRegions invokes ListWorkspaces concurrently in each of "regions" and merges the results.
+// If "regions" is empty, all the regions returned by Regions() are queried.
+// Each region is paged through, starting at the Offset in "listWorkspacesOptions" with pages of Limit items
+// (DefaultPageSize if not set). The items of a region that fails while paging are discarded.
+func (router *RoutingSchematicsV1) ListWorkspacesInRegions(regions []string, listWorkspacesOptions *ListWorkspacesOptions) *MultiRegionWorkspaceList {
+	return router.ListWorkspacesInRegionsWithContext(context.Background(), regions, listWorkspacesOptions)
+}
+
+// ListWorkspacesInRegionsWithContext is an alternate form of the ListWorkspacesInRegions method which supports a Context parameter
+func (router *RoutingSchematicsV1) ListWorkspacesInRegionsWithContext(ctx context.Context, regions []string, listWorkspacesOptions *ListWorkspacesOptions) *MultiRegionWorkspaceList {
+	if len(regions) == 0 {
+		regions = Regions()
+	}
+	if listWorkspacesOptions == nil {
+		listWorkspacesOptions = &ListWorkspacesOptions{}
+	}
+
+	items := make([][]WorkspaceResponse, len(regions))
+	counts := make([]int64, len(regions))
+	result := &MultiRegionWorkspaceList{}
+	result.Errors = router.fanOut(regions, func(index int, client *SchematicsV1) error {
+		pager, err := client.NewWorkspacesPager(listWorkspacesOptions)
+		if err != nil {
+			return err
+		}
+		regionItems, err := pager.AllWithContext(ctx)
+		if err != nil {
+			return err
+		}
+		items[index], counts[index] = regionItems, pager.total()
+		return nil
+	})
+
+	for index, regionItems := range items {
+		result.Count += counts[index]
+		for _, workspace := range regionItems {
+			result.Workspaces = append(result.Workspaces, RegionalWorkspace{Region: regions[index], WorkspaceResponse: workspace})
+		}
+	}
+	return result
+}
+
+// ListActionsInRegions invokes ListActions concurrently in each of "regions" and merges the results.
+// If "regions" is empty, all the regions returned by Regions() are queried.
+// Each region is paged through, starting at the Offset in "listActionsOptions" with pages of Limit items
+// (DefaultPageSize if not set). The items of a region that fails while paging are discarded.
+func (router *RoutingSchematicsV1) ListActionsInRegions(regions []string, listActionsOptions *ListActionsOptions) *MultiRegionActionList {
+	return router.ListActionsInRegionsWithContext(context.Background(), regions, listActionsOptions)
+}
+
+// ListActionsInRegionsWithContext is an alternate form of the ListActionsInRegions method which supports a Context parameter
+func (router *RoutingSchematicsV1) ListActionsInRegionsWithContext(ctx context.Context, regions []string, listActionsOptions *ListActionsOptions) *MultiRegionActionList {
+	if len(regions) == 0 {
+		regions = Regions()
+	}
+	if listActionsOptions == nil {
+		listActionsOptions = &ListActionsOptions{}
+	}
+
+	items := make([][]ActionLite, len(regions))
+	counts := make([]int64, len(regions))
+	result := &MultiRegionActionList{}
+	result.Errors = router.fanOut(regions, func(index int, client *SchematicsV1) error {
+		pager, err := client.NewActionsPager(listActionsOptions)
+		if err != nil {
+			return err
+		}
+		regionItems, err := pager.AllWithContext(ctx)
+		if err != nil {
+			return err
+		}
+		items[index], counts[index] = regionItems, pager.total()
+		return nil
+	})
+
+	for index, regionItems := range items {
+		result.TotalCount += counts[index]
+		for _, action := range regionItems {
+			result.Actions = append(result.Actions, RegionalAction{Region: regions[index], ActionLite: action})
+		}
+	}
+	return result
+}
+
+// ListJobsInRegions invokes ListJobs concurrently in each of "regions" and merges the results.
+// If "regions" is empty, all the regions returned by Regions() are queried.
+// Each region is paged through, starting at the Offset in "listJobsOptions" with pages of Limit items
+// (DefaultPageSize if not set). The items of a region that fails while paging are discarded.
+func (router *RoutingSchematicsV1) ListJobsInRegions(regions []string, listJobsOptions *ListJobsOptions) *MultiRegionJobList {
+	return router.ListJobsInRegionsWithContext(context.Background(), regions, listJobsOptions)
+}
+
+// ListJobsInRegionsWithContext is an alternate form of the ListJobsInRegions method which supports a Context parameter
+func (router *RoutingSchematicsV1) ListJobsInRegionsWithContext(ctx context.Context, regions []string, listJobsOptions *ListJobsOptions) *MultiRegionJobList {
+	if len(regions) == 0 {
+		regions = Regions()
+	}
+	if listJobsOptions == nil {
+		listJobsOptions = &ListJobsOptions{}
+	}
+
+	items := make([][]JobLite, len(regions))
+	counts := make([]int64, len(regions))
+	result := &MultiRegionJobList{}
+	result.Errors = router.fanOut(regions, func(index int, client *SchematicsV1) error {
+		pager, err := client.NewJobsPager(listJobsOptions)
+		if err != nil {
+			return err
+		}
+		regionItems, err := pager.AllWithContext(ctx)
+		if err != nil {
+			return err
+		}
+		items[index], counts[index] = regionItems, pager.total()
+		return nil
+	})
+
+	for index, regionItems := range items {
+		result.TotalCount += counts[index]
+		for _, job := range regionItems {
+			result.Jobs = append(result.Jobs, RegionalJob{Region: regions[index], JobLite: job})
+		}
+	}
+	return result
+}
+
+// fanOut invokes "list" concurrently with the client for each of "regions", passing the index of the region
+// within "regions", and returns an error for each region that failed, in the order of "regions", with the detailed
+// response of the failed request if the error carries one.
+func (router *RoutingSchematicsV1) fanOut(regions []string, list func(index int, client *SchematicsV1) error) []*RegionError {
+	regionErrors := make([]*RegionError, len(regions))
+
+	var wg sync.WaitGroup
+	for index, region := range regions {
+		client, err := router.ClientForRegion(region)
+		if err != nil {
+			regionErrors[index] = &RegionError{Region: region, Err: err}
+			continue
+		}
+
+		wg.Add(1)
+		go func(index int, region string, client *SchematicsV1) {
+			defer wg.Done()
+			if err := list(index, client); err != nil {
+				regionErrors[index] = &RegionError{Region: region, Err: err}
+				if schematicsError := asSchematicsError(err); schematicsError != nil {
+					regionErrors[index].Response = schematicsError.Response
+				}
+			}
+		}(index, region, client)
+	}
+	wg.Wait()
+
+	var failed []*RegionError
+	for _, regionError := range regionErrors {
+		if regionError != nil {
+			failed = append(failed, regionError)
+		}
+	}
+	return failed
+}
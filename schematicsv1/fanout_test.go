@@ -0,0 +1,129 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Multi-region listing`, func() {
+	var router *schematicsv1.RoutingSchematicsV1
+	var mutex sync.Mutex
+	var requestedHosts []string
+
+	BeforeEach(func() {
+		schematicsService, serviceErr := schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		requestedHosts = nil
+		schematicsService.Service.SetHTTPClient(&http.Client{
+			Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				mutex.Lock()
+				requestedHosts = append(requestedHosts, req.URL.Host)
+				mutex.Unlock()
+
+				region := strings.SplitN(req.URL.Host, ".", 2)[0]
+				if region == "eu-de" {
+					return jsonResponse(req, 500, `{"error": "internal error"}`), nil
+				}
+				switch req.URL.Path {
+				case "/v1/workspaces":
+					return jsonResponse(req, 200, `{"count": 1, "limit": 100, "offset": 0, "workspaces": [{"id": "`+region+`.workspace.foo.abc123"}]}`), nil
+				case "/v2/actions":
+					return jsonResponse(req, 200, `{"total_count": 1, "limit": 100, "offset": 0, "actions": [{"id": "`+region+`.ACTION.foo.abc123"}]}`), nil
+				default:
+					return jsonResponse(req, 200, `{"total_count": 1, "limit": 100, "offset": 0, "jobs": [{"id": "`+region+`.JOB.foo.abc123"}]}`), nil
+				}
+			}),
+		})
+		router = schematicsv1.NewRoutingSchematicsV1(schematicsService)
+	})
+
+	It(`Invoke ListWorkspacesInRegions`, func() {
+		result := router.ListWorkspacesInRegions([]string{"us-south", "eu-de", "ca-tor"}, &schematicsv1.ListWorkspacesOptions{})
+		Expect(result.Count).To(Equal(int64(2)))
+		Expect(result.Workspaces).To(HaveLen(2))
+		Expect(result.Workspaces[0].Region).To(Equal("us-south"))
+		Expect(*result.Workspaces[0].ID).To(Equal("us-south.workspace.foo.abc123"))
+		Expect(result.Workspaces[1].Region).To(Equal("ca-tor"))
+		Expect(*result.Workspaces[1].ID).To(Equal("ca-tor.workspace.foo.abc123"))
+
+		Expect(result.Errors).To(HaveLen(1))
+		Expect(result.Errors[0].Region).To(Equal("eu-de"))
+		Expect(result.Errors[0].Response.StatusCode).To(Equal(500))
+		Expect(result.Errors[0].Error()).To(Equal("region 'eu-de': internal error"))
+	})
+	It(`Invoke ListActionsInRegions with all regions`, func() {
+		result := router.ListActionsInRegions(nil, &schematicsv1.ListActionsOptions{})
+		Expect(requestedHosts).To(HaveLen(len(schematicsv1.Regions())))
+		Expect(result.TotalCount).To(Equal(int64(4)))
+		Expect(result.Actions).To(HaveLen(4))
+		Expect(result.Actions[0].Region).To(Equal("ca-tor"))
+		Expect(result.Errors).To(HaveLen(1))
+	})
+	It(`Invoke ListJobsInRegions with several pages per region`, func() {
+		schematicsService, serviceErr := schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		var offsets []string
+		schematicsService.Service.SetHTTPClient(&http.Client{
+			Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				mutex.Lock()
+				offsets = append(offsets, req.URL.Query().Get("offset"))
+				mutex.Unlock()
+
+				offset, _ := strconv.Atoi(req.URL.Query().Get("offset"))
+				limit, _ := strconv.Atoi(req.URL.Query().Get("limit"))
+				var jobs []string
+				for i := offset; i < offset+limit && i < 5; i++ {
+					jobs = append(jobs, fmt.Sprintf(`{"id": "us-south.JOB.foo.%d"}`, i))
+				}
+				return jsonResponse(req, 200, `{"total_count": 5, "jobs": [`+strings.Join(jobs, ", ")+`]}`), nil
+			}),
+		})
+		router := schematicsv1.NewRoutingSchematicsV1(schematicsService)
+
+		result := router.ListJobsInRegions([]string{"us-south"}, &schematicsv1.ListJobsOptions{Limit: core.Int64Ptr(2)})
+		Expect(result.Errors).To(BeEmpty())
+		Expect(result.TotalCount).To(Equal(int64(5)))
+		Expect(result.Jobs).To(HaveLen(5))
+		Expect(*result.Jobs[4].ID).To(Equal("us-south.JOB.foo.4"))
+		Expect(offsets).To(Equal([]string{"0", "2", "4"}))
+	})
+	It(`Invoke ListJobsInRegions with an unknown region`, func() {
+		result := router.ListJobsInRegions([]string{"INVALID_REGION", "us-east"}, &schematicsv1.ListJobsOptions{})
+		Expect(requestedHosts).To(Equal([]string{"us-east.schematics.cloud.ibm.com"}))
+		Expect(result.Jobs).To(HaveLen(1))
+		Expect(result.Jobs[0].Region).To(Equal("us-east"))
+		Expect(*result.Jobs[0].ID).To(Equal("us-east.JOB.foo.abc123"))
+		Expect(result.Errors).To(HaveLen(1))
+		Expect(result.Errors[0].Region).To(Equal("INVALID_REGION"))
+		Expect(result.Errors[0].Response).To(BeNil())
+	})
+})
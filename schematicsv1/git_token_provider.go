@@ -0,0 +1,409 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+)
+
+// DefaultGitTokenHost : The host under which SetGitTokenProvider registers the provider used for the repositories
+// whose host has no provider of its own.
+const DefaultGitTokenHost = "*"
+
+// GitTokenProvider : The source of the tokens used by Schematics to read private Git repositories, sent in the
+// X-Github-token header and the git_token property of operations such as CreateWorkspace and CreateAction.
+// Implementations must be safe for concurrent use.
+type GitTokenProvider interface {
+	// GitToken returns the token for the repository at "repoURL", or an empty string if the provider has no token
+	// for it.
+	GitToken(ctx context.Context, repoURL *url.URL) (string, error)
+}
+
+// gitTokenProviders maps the hosts of Git repositories to the provider of their tokens.
+type gitTokenProviders map[string]GitTokenProvider
+
+// SetGitTokenProvider sets the provider of the tokens for the Git repositories hosted on "host" (e.g.
+// "github.com"), or for the repositories of all the hosts without a provider of their own if "host" is
+// DefaultGitTokenHost; a nil provider removes the provider of "host".
+//
+// The tokens are supplied when they are not set, before the options are validated:
+//   - to ProcessTemplateMetaData, CreateAction and UpdateAction, in the git_token property of the Git source and in
+//     the X-Github-token header;
+//   - to CreateBlueprint and ReplaceBlueprint, in the git_token property of the Git sources of the blueprint and of
+//     its configuration items;
+//   - to CreateWorkspace and ReplaceWorkspace, in the X-Github-token header only, since the template repository of
+//     a workspace has no token property, and in the tft_git_token property of the Terraform template injectors.
+//
+// The options of the caller are not modified. The providers are shared with the clones of the instance made after
+// the call.
+func (schematics *SchematicsV1) SetGitTokenProvider(host string, provider GitTokenProvider) {
+	providers := make(gitTokenProviders, len(schematics.gitTokenProviders)+1)
+	for existingHost, existing := range schematics.gitTokenProviders {
+		providers[existingHost] = existing
+	}
+	host = strings.ToLower(host)
+	if provider == nil {
+		delete(providers, host)
+	} else {
+		providers[host] = provider
+	}
+	schematics.gitTokenProviders = providers
+}
+
+// token returns the token for the repository at "repoURL", or nil if there is no repository or no token for it.
+func (providers gitTokenProviders) token(ctx context.Context, repoURL *string) (*string, error) {
+	if repoURL == nil || *repoURL == "" {
+		return nil, nil
+	}
+	parsed, err := parseRepoURL(*repoURL)
+	if err != nil {
+		return nil, err
+	}
+	provider, ok := providers[strings.ToLower(parsed.Hostname())]
+	if !ok {
+		provider, ok = providers[DefaultGitTokenHost]
+	}
+	if !ok {
+		return nil, nil
+	}
+	token, err := provider.GitToken(ctx, parsed)
+	if err != nil {
+		return nil, fmt.Errorf("unable to obtain a Git token for '%s': %w", parsed.Redacted(), err)
+	}
+	if token == "" {
+		return nil, nil
+	}
+	return &token, nil
+}
+
+// parseRepoURL parses the URL of a Git repository, including the scp-like syntax "git@github.com:org/repo.git".
+func parseRepoURL(repoURL string) (*url.URL, error) {
+	if !strings.Contains(repoURL, "://") {
+		if userHost, path, ok := strings.Cut(repoURL, ":"); ok && !strings.Contains(userHost, "/") {
+			user, host, found := strings.Cut(userHost, "@")
+			if !found {
+				user, host = "", userHost
+			}
+			parsed := &url.URL{Scheme: "ssh", Host: host, Path: "/" + strings.TrimPrefix(path, "/")}
+			if user != "" {
+				parsed.User = url.User(user)
+			}
+			return parsed, nil
+		}
+		repoURL = "https://" + repoURL
+	}
+	parsed, err := url.Parse(repoURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Git repository URL: %w", err)
+	}
+	return parsed, nil
+}
+
+// withProvidedGitToken returns "source" with its Git token supplied by "providers" if it is not set, and the token
+// of its repository, if any. "source" is copied before it is changed.
+func (providers gitTokenProviders) withProvidedGitToken(ctx context.Context, source *ExternalSource) (*ExternalSource, *string, error) {
+	if source == nil || source.Git == nil {
+		return source, nil, nil
+	}
+	if source.Git.GitToken != nil && *source.Git.GitToken != "" {
+		return source, source.Git.GitToken, nil
+	}
+	token, err := providers.token(ctx, source.Git.GitRepoURL)
+	if err != nil || token == nil {
+		return source, nil, err
+	}
+	copiedSource, copiedGit := *source, *source.Git
+	copiedGit.GitToken = token
+	copiedSource.Git = &copiedGit
+	return &copiedSource, token, nil
+}
+
+// templateRepoURL returns the URL of a template repository, preferring its source URL.
+func templateRepoURL(sourceURL *string, repoURL *string) *string {
+	if sourceURL != nil && *sourceURL != "" {
+		return sourceURL
+	}
+	return repoURL
+}
+
+// withProvidedInjectorTokens returns "templateData" with the tokens of the Terraform template injectors supplied by
+// "providers" if they are not set. "templateData" is copied before it is changed.
+func (providers gitTokenProviders) withProvidedInjectorTokens(ctx context.Context, templateData []TemplateSourceDataRequest) ([]TemplateSourceDataRequest, error) {
+	var copied []TemplateSourceDataRequest
+	for i, template := range templateData {
+		var injectors []InjectTerraformTemplateItem
+		for j, injector := range template.Injectors {
+			if injector.TftGitToken != nil && *injector.TftGitToken != "" {
+				continue
+			}
+			token, err := providers.token(ctx, injector.TftGitURL)
+			if err != nil {
+				return nil, err
+			}
+			if token == nil {
+				continue
+			}
+			if injectors == nil {
+				injectors = slices.Clone(template.Injectors)
+			}
+			injectors[j].TftGitToken = token
+		}
+		if injectors == nil {
+			continue
+		}
+		if copied == nil {
+			copied = slices.Clone(templateData)
+		}
+		copied[i].Injectors = injectors
+	}
+	if copied == nil {
+		return templateData, nil
+	}
+	return copied, nil
+}
+
+// withProvidedGitToken returns a copy of the options with the Git tokens supplied by "providers" if they are not
+// set.
+func (options *CreateWorkspaceOptions) withProvidedGitToken(ctx context.Context, providers gitTokenProviders) (*CreateWorkspaceOptions, error) {
+	copied := *options
+	if (copied.XGithubToken == nil || *copied.XGithubToken == "") && copied.TemplateRepo != nil {
+		token, err := providers.token(ctx, templateRepoURL(copied.TemplateRepo.URL, copied.TemplateRepo.RepoURL))
+		if err != nil {
+			return nil, err
+		}
+		if token != nil {
+			copied.XGithubToken = token
+		}
+	}
+	var err error
+	if copied.TemplateData, err = providers.withProvidedInjectorTokens(ctx, copied.TemplateData); err != nil {
+		return nil, err
+	}
+	return &copied, nil
+}
+
+// withProvidedGitToken returns a copy of the options with the Git tokens supplied by "providers" if they are not
+// set.
+func (options *ReplaceWorkspaceOptions) withProvidedGitToken(ctx context.Context, providers gitTokenProviders) (*ReplaceWorkspaceOptions, error) {
+	copied := *options
+	if (copied.XGithubToken == nil || *copied.XGithubToken == "") && copied.TemplateRepo != nil {
+		token, err := providers.token(ctx, templateRepoURL(copied.TemplateRepo.URL, copied.TemplateRepo.RepoURL))
+		if err != nil {
+			return nil, err
+		}
+		if token != nil {
+			copied.XGithubToken = token
+		}
+	}
+	var err error
+	if copied.TemplateData, err = providers.withProvidedInjectorTokens(ctx, copied.TemplateData); err != nil {
+		return nil, err
+	}
+	return &copied, nil
+}
+
+// withProvidedConfigTokens returns "config" with the Git tokens of the sources of its items supplied by "providers"
+// if they are not set. "config" is copied before it is changed.
+func (providers gitTokenProviders) withProvidedConfigTokens(ctx context.Context, config []BlueprintConfigItem) ([]BlueprintConfigItem, error) {
+	var copied []BlueprintConfigItem
+	for i, item := range config {
+		source, _, err := providers.withProvidedGitToken(ctx, item.Source)
+		if err != nil {
+			return nil, err
+		}
+		if source == item.Source {
+			continue
+		}
+		if copied == nil {
+			copied = slices.Clone(config)
+		}
+		copied[i].Source = source
+	}
+	if copied == nil {
+		return config, nil
+	}
+	return copied, nil
+}
+
+// withProvidedGitToken returns a copy of the options with the Git tokens supplied by "providers" if they are not
+// set.
+func (options *CreateBlueprintOptions) withProvidedGitToken(ctx context.Context, providers gitTokenProviders) (*CreateBlueprintOptions, error) {
+	copied := *options
+	var err error
+	if copied.Source, _, err = providers.withProvidedGitToken(ctx, copied.Source); err != nil {
+		return nil, err
+	}
+	if copied.Config, err = providers.withProvidedConfigTokens(ctx, copied.Config); err != nil {
+		return nil, err
+	}
+	return &copied, nil
+}
+
+// withProvidedGitToken returns a copy of the options with the Git tokens supplied by "providers" if they are not
+// set.
+func (options *ReplaceBlueprintOptions) withProvidedGitToken(ctx context.Context, providers gitTokenProviders) (*ReplaceBlueprintOptions, error) {
+	copied := *options
+	var err error
+	if copied.Source, _, err = providers.withProvidedGitToken(ctx, copied.Source); err != nil {
+		return nil, err
+	}
+	if copied.Config, err = providers.withProvidedConfigTokens(ctx, copied.Config); err != nil {
+		return nil, err
+	}
+	return &copied, nil
+}
+
+// withProvidedGitToken returns a copy of the options with the Git tokens supplied by "providers" if they are not
+// set.
+func (options *ProcessTemplateMetaDataOptions) withProvidedGitToken(ctx context.Context, providers gitTokenProviders) (*ProcessTemplateMetaDataOptions, error) {
+	copied := *options
+	var token *string
+	var err error
+	if copied.Source, token, err = providers.withProvidedGitToken(ctx, copied.Source); err != nil {
+		return nil, err
+	}
+	if (copied.XGithubToken == nil || *copied.XGithubToken == "") && token != nil {
+		copied.XGithubToken = token
+	}
+	return &copied, nil
+}
+
+// withProvidedGitToken returns a copy of the options with the Git tokens supplied by "providers" if they are not
+// set.
+func (options *CreateActionOptions) withProvidedGitToken(ctx context.Context, providers gitTokenProviders) (*CreateActionOptions, error) {
+	copied := *options
+	var token *string
+	var err error
+	if copied.Source, token, err = providers.withProvidedGitToken(ctx, copied.Source); err != nil {
+		return nil, err
+	}
+	if (copied.XGithubToken == nil || *copied.XGithubToken == "") && token != nil {
+		copied.XGithubToken = token
+	}
+	return &copied, nil
+}
+
+// withProvidedGitToken returns a copy of the options with the Git tokens supplied by "providers" if they are not
+// set.
+func (options *UpdateActionOptions) withProvidedGitToken(ctx context.Context, providers gitTokenProviders) (*UpdateActionOptions, error) {
+	copied := *options
+	var token *string
+	var err error
+	if copied.Source, token, err = providers.withProvidedGitToken(ctx, copied.Source); err != nil {
+		return nil, err
+	}
+	if (copied.XGithubToken == nil || *copied.XGithubToken == "") && token != nil {
+		copied.XGithubToken = token
+	}
+	return &copied, nil
+}
+
+// EnvGitTokenProvider : A GitTokenProvider that reads the token from an environment variable.
+type EnvGitTokenProvider struct {
+	variable string
+}
+
+// NewEnvGitTokenProvider constructs an EnvGitTokenProvider that reads the token from the environment variable
+// "variable", e.g. "GITHUB_TOKEN".
+func NewEnvGitTokenProvider(variable string) *EnvGitTokenProvider {
+	return &EnvGitTokenProvider{variable: variable}
+}
+
+// GitToken returns the value of the environment variable of the provider.
+func (provider *EnvGitTokenProvider) GitToken(ctx context.Context, repoURL *url.URL) (string, error) {
+	return strings.TrimSpace(os.Getenv(provider.variable)), nil
+}
+
+// FileGitTokenProvider : A GitTokenProvider that reads the token from a file, such as a mounted secret. The file
+// is read on each call, so that rotated tokens are picked up.
+type FileGitTokenProvider struct {
+	path string
+}
+
+// NewFileGitTokenProvider constructs a FileGitTokenProvider that reads the token from the file "path".
+func NewFileGitTokenProvider(path string) *FileGitTokenProvider {
+	return &FileGitTokenProvider{path: path}
+}
+
+// GitToken returns the content of the file of the provider, without surrounding white space.
+func (provider *FileGitTokenProvider) GitToken(ctx context.Context, repoURL *url.URL) (string, error) {
+	data, err := os.ReadFile(provider.path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// CredentialHelperGitTokenProvider : A GitTokenProvider that asks the Git credential helpers configured for the
+// user, by running "git credential fill", and returns the password of the credential.
+type CredentialHelperGitTokenProvider struct {
+	command string
+}
+
+// NewCredentialHelperGitTokenProvider constructs a CredentialHelperGitTokenProvider that runs the Git executable
+// "command", or "git" if empty.
+func NewCredentialHelperGitTokenProvider(command string) *CredentialHelperGitTokenProvider {
+	if command == "" {
+		command = "git"
+	}
+	return &CredentialHelperGitTokenProvider{command: command}
+}
+
+// GitToken returns the password of the credential of "repoURL" returned by the Git credential helpers, or an empty
+// string if they have none. Git is not allowed to prompt for a credential.
+func (provider *CredentialHelperGitTokenProvider) GitToken(ctx context.Context, repoURL *url.URL) (string, error) {
+	scheme := repoURL.Scheme
+	if scheme == "" || scheme == "ssh" {
+		scheme = "https"
+	}
+	var input bytes.Buffer
+	fmt.Fprintf(&input, "protocol=%s\nhost=%s\n", scheme, repoURL.Host)
+	if path := strings.TrimPrefix(repoURL.Path, "/"); path != "" {
+		fmt.Fprintf(&input, "path=%s\n", path)
+	}
+	input.WriteString("\n")
+
+	command := exec.CommandContext(ctx, provider.command, "credential", "fill")
+	command.Stdin = &input
+	command.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0", "GCM_INTERACTIVE=never")
+	var output, stderr bytes.Buffer
+	command.Stdout = &output
+	command.Stderr = &stderr
+	if err := command.Run(); err != nil {
+		// Without a credential and a terminal, git fails when it would prompt for the user name.
+		if strings.Contains(stderr.String(), "terminal prompts disabled") {
+			return "", nil
+		}
+		return "", fmt.Errorf("git credential fill: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	scanner := bufio.NewScanner(&output)
+	for scanner.Scan() {
+		if password, ok := strings.CutPrefix(scanner.Text(), "password="); ok {
+			return password, nil
+		}
+	}
+	return "", scanner.Err()
+}
@@ -0,0 +1,472 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// IdempotencyTagPrefix is the prefix of the tag that marks the jobs and workspaces created with an idempotency key.
+const IdempotencyTagPrefix = "idempotency-key:"
+
+// maxIdempotencyLookupItems is the maximum number of items inspected when looking for the resource created
+// by an earlier submission.
+const maxIdempotencyLookupItems = 2000
+
+// idempotencyClockSkew is the tolerated difference between the local clock and the service clock when matching
+// workspace activities to the time of an earlier submission.
+const idempotencyClockSkew = 30 * time.Second
+
+// IdempotencyRecord : The state of a call made with an idempotency key.
+type IdempotencyRecord struct {
+	// The idempotency key.
+	Key string `json:"key"`
+
+	// The operationId of the operation, e.g. "CreateJob".
+	OperationID string `json:"operation_id"`
+
+	// The time at which the request was last submitted.
+	SubmittedAt time.Time `json:"submitted_at"`
+
+	// The ID of the resource created by the call: a job ID, a workspace ID or a workspace activity ID.
+	// It is empty while the outcome of the submission is unknown.
+	ResourceID string `json:"resource_id,omitempty"`
+}
+
+// IdempotencyStore : The local storage of IdempotencyRecord instances. Implementations must be safe for
+// concurrent use.
+type IdempotencyStore interface {
+	// Get returns the record with "key", or nil if there is none.
+	Get(key string) (*IdempotencyRecord, error)
+
+	// Put creates or replaces the record with the key of "record".
+	Put(record *IdempotencyRecord) error
+
+	// Delete removes the record with "key", if any.
+	Delete(key string) error
+}
+
+// MemoryIdempotencyStore : An IdempotencyStore that keeps the records in memory, for the lifetime of the process.
+type MemoryIdempotencyStore struct {
+	mutex   sync.Mutex
+	records map[string]IdempotencyRecord
+}
+
+// NewMemoryIdempotencyStore constructs an empty MemoryIdempotencyStore.
+func NewMemoryIdempotencyStore() *MemoryIdempotencyStore {
+	return &MemoryIdempotencyStore{records: make(map[string]IdempotencyRecord)}
+}
+
+// Get returns the record with "key", or nil if there is none.
+func (store *MemoryIdempotencyStore) Get(key string) (*IdempotencyRecord, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	if record, ok := store.records[key]; ok {
+		return &record, nil
+	}
+	return nil, nil
+}
+
+// Put creates or replaces the record with the key of "record".
+func (store *MemoryIdempotencyStore) Put(record *IdempotencyRecord) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	store.records[record.Key] = *record
+	return nil
+}
+
+// Delete removes the record with "key", if any.
+func (store *MemoryIdempotencyStore) Delete(key string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	delete(store.records, key)
+	return nil
+}
+
+// FileIdempotencyStore : An IdempotencyStore that persists each record as a JSON file in a directory, so that
+// the records survive process restarts.
+type FileIdempotencyStore struct {
+	dir string
+}
+
+// NewFileIdempotencyStore constructs a FileIdempotencyStore that keeps its records in "dir", creating the
+// directory if needed.
+func NewFileIdempotencyStore(dir string) (*FileIdempotencyStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &FileIdempotencyStore{dir: dir}, nil
+}
+
+// path returns the path of the file of the record with "key".
+func (store *FileIdempotencyStore) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(store.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// Get returns the record with "key", or nil if there is none.
+func (store *FileIdempotencyStore) Get(key string) (*IdempotencyRecord, error) {
+	data, err := os.ReadFile(store.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	record := &IdempotencyRecord{}
+	if err := json.Unmarshal(data, record); err != nil {
+		return nil, fmt.Errorf("error reading idempotency record for key '%s': %w", key, err)
+	}
+	return record, nil
+}
+
+// Put creates or replaces the record with the key of "record". The file is replaced atomically.
+func (store *FileIdempotencyStore) Put(record *IdempotencyRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	file, err := os.CreateTemp(store.dir, ".record-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), store.path(record.Key))
+}
+
+// Delete removes the record with "key", if any.
+func (store *FileIdempotencyStore) Delete(key string) error {
+	err := os.Remove(store.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// IdempotentSchematicsV1 : A SchematicsV1 wrapper whose Idempotent* methods can safely be retried after an
+// ambiguous failure, such as a network timeout, without creating duplicate jobs or workspaces.
+//
+// Each call carries an idempotency key that is persisted in an IdempotencyStore before the request is submitted.
+// When a call is repeated with a key whose earlier submission has an unknown outcome, the resource created by that
+// submission is looked up and returned instead of submitting the request again: jobs and workspaces are matched by
+// a tag with the IdempotencyTagPrefix, and workspace apply activities by their name and submission time.
+// Concurrent calls with the same key are not supported.
+type IdempotentSchematicsV1 struct {
+	*SchematicsV1
+	store IdempotencyStore
+}
+
+// NewIdempotentSchematicsV1 constructs an IdempotentSchematicsV1 that sends requests with "schematics" and
+// persists idempotency records in "store".
+func NewIdempotentSchematicsV1(schematics *SchematicsV1, store IdempotencyStore) *IdempotentSchematicsV1 {
+	return &IdempotentSchematicsV1{SchematicsV1: schematics, store: store}
+}
+
+// IdempotentCreateJob : Create a job, or return the job created earlier with the same idempotency key.
+func (idempotent *IdempotentSchematicsV1) IdempotentCreateJob(key string, createJobOptions *CreateJobOptions) (result *Job, response *core.DetailedResponse, err error) {
+	return idempotent.IdempotentCreateJobWithContext(context.Background(), key, createJobOptions)
+}
+
+// IdempotentCreateJobWithContext is an alternate form of the IdempotentCreateJob method which supports a Context parameter
+func (idempotent *IdempotentSchematicsV1) IdempotentCreateJobWithContext(ctx context.Context, key string, createJobOptions *CreateJobOptions) (result *Job, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(createJobOptions, "createJobOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	// The options are validated before the operation is recorded, with the tokens that the wrapped method would
+	// otherwise obtain from the token provider.
+	if idempotent.tokenProvider != nil {
+		createJobOptions, err = createJobOptions.withProvidedTokens(ctx, idempotent.tokenProvider)
+		if err != nil {
+			return
+		}
+	}
+	err = core.ValidateStruct(createJobOptions, "createJobOptions")
+	if err != nil {
+		return
+	}
+	record, err := idempotent.begin(key, "CreateJob")
+	if err != nil {
+		return
+	}
+
+	tag := IdempotencyTagPrefix + key
+	if record.ResourceID == "" && !record.SubmittedAt.IsZero() {
+		err = idempotent.findJob(ctx, record, tag, createJobOptions)
+		if err != nil {
+			return
+		}
+	}
+	if record.ResourceID != "" {
+		return idempotent.GetJobWithContext(ctx, idempotent.NewGetJobOptions(record.ResourceID))
+	}
+
+	options := *createJobOptions
+	options.Tags = append(slices.Clone(options.Tags), tag)
+	err = idempotent.submit(record)
+	if err != nil {
+		return
+	}
+	result, response, err = idempotent.SchematicsV1.CreateJobWithContext(ctx, &options)
+	if result != nil {
+		idempotent.complete(record, result.ID, response, err)
+	} else {
+		idempotent.complete(record, nil, response, err)
+	}
+	return
+}
+
+// findJob looks for the job tagged with "tag" and records its ID in "record".
+func (idempotent *IdempotentSchematicsV1) findJob(ctx context.Context, record *IdempotencyRecord, tag string, createJobOptions *CreateJobOptions) error {
+	listJobsOptions := &ListJobsOptions{}
+	switch core.StringNilMapper(createJobOptions.CommandObject) {
+	case CreateJobOptions_CommandObject_Action:
+		listJobsOptions.Resource = core.StringPtr(ListJobsOptions_Resource_Action)
+		listJobsOptions.ActionID = createJobOptions.CommandObjectID
+	case CreateJobOptions_CommandObject_Workspace:
+		listJobsOptions.Resource = core.StringPtr(ListJobsOptions_Resource_Workspaces)
+		listJobsOptions.WorkspaceID = createJobOptions.CommandObjectID
+	}
+	pager, err := idempotent.NewJobsPager(listJobsOptions)
+	if err != nil {
+		return err
+	}
+	for job, err := range pager.SetMaxItems(maxIdempotencyLookupItems).Items(ctx) {
+		if err != nil {
+			return err
+		}
+		if job.ID != nil && slices.Contains(job.Tags, tag) {
+			return idempotent.found(record, *job.ID)
+		}
+	}
+	return nil
+}
+
+// IdempotentCreateWorkspace : Create a workspace, or return the workspace created earlier with the same idempotency key.
+func (idempotent *IdempotentSchematicsV1) IdempotentCreateWorkspace(key string, createWorkspaceOptions *CreateWorkspaceOptions) (result *WorkspaceResponse, response *core.DetailedResponse, err error) {
+	return idempotent.IdempotentCreateWorkspaceWithContext(context.Background(), key, createWorkspaceOptions)
+}
+
+// IdempotentCreateWorkspaceWithContext is an alternate form of the IdempotentCreateWorkspace method which supports a Context parameter
+func (idempotent *IdempotentSchematicsV1) IdempotentCreateWorkspaceWithContext(ctx context.Context, key string, createWorkspaceOptions *CreateWorkspaceOptions) (result *WorkspaceResponse, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(createWorkspaceOptions, "createWorkspaceOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(createWorkspaceOptions, "createWorkspaceOptions")
+	if err != nil {
+		return
+	}
+	record, err := idempotent.begin(key, "CreateWorkspace")
+	if err != nil {
+		return
+	}
+
+	tag := IdempotencyTagPrefix + key
+	if record.ResourceID == "" && !record.SubmittedAt.IsZero() {
+		err = idempotent.findWorkspace(ctx, record, tag)
+		if err != nil {
+			return
+		}
+	}
+	if record.ResourceID != "" {
+		return idempotent.GetWorkspaceWithContext(ctx, idempotent.NewGetWorkspaceOptions(record.ResourceID))
+	}
+
+	options := *createWorkspaceOptions
+	options.Tags = append(slices.Clone(options.Tags), tag)
+	err = idempotent.submit(record)
+	if err != nil {
+		return
+	}
+	result, response, err = idempotent.SchematicsV1.CreateWorkspaceWithContext(ctx, &options)
+	if result != nil {
+		idempotent.complete(record, result.ID, response, err)
+	} else {
+		idempotent.complete(record, nil, response, err)
+	}
+	return
+}
+
+// findWorkspace looks for the workspace tagged with "tag" and records its ID in "record".
+func (idempotent *IdempotentSchematicsV1) findWorkspace(ctx context.Context, record *IdempotencyRecord, tag string) error {
+	pager, err := idempotent.NewWorkspacesPager(&ListWorkspacesOptions{})
+	if err != nil {
+		return err
+	}
+	for workspace, err := range pager.SetMaxItems(maxIdempotencyLookupItems).Items(ctx) {
+		if err != nil {
+			return err
+		}
+		if workspace.ID != nil && slices.Contains(workspace.Tags, tag) {
+			return idempotent.found(record, *workspace.ID)
+		}
+	}
+	return nil
+}
+
+// IdempotentApplyWorkspaceCommand : Run a workspace apply job, or return the apply job started earlier with the same
+// idempotency key.
+//
+// Workspace activities cannot be tagged, so an earlier submission with an unknown outcome is matched to the first
+// APPLY activity of the workspace that was performed after the time of that submission.
+func (idempotent *IdempotentSchematicsV1) IdempotentApplyWorkspaceCommand(key string, applyWorkspaceCommandOptions *ApplyWorkspaceCommandOptions) (result *WorkspaceActivityApplyResult, response *core.DetailedResponse, err error) {
+	return idempotent.IdempotentApplyWorkspaceCommandWithContext(context.Background(), key, applyWorkspaceCommandOptions)
+}
+
+// IdempotentApplyWorkspaceCommandWithContext is an alternate form of the IdempotentApplyWorkspaceCommand method which supports a Context parameter
+func (idempotent *IdempotentSchematicsV1) IdempotentApplyWorkspaceCommandWithContext(ctx context.Context, key string, applyWorkspaceCommandOptions *ApplyWorkspaceCommandOptions) (result *WorkspaceActivityApplyResult, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(applyWorkspaceCommandOptions, "applyWorkspaceCommandOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	// The options are validated before the operation is recorded, with the tokens that the wrapped method would
+	// otherwise obtain from the token provider.
+	if idempotent.tokenProvider != nil {
+		applyWorkspaceCommandOptions, err = applyWorkspaceCommandOptions.withProvidedTokens(ctx, idempotent.tokenProvider)
+		if err != nil {
+			return
+		}
+	}
+	err = core.ValidateStruct(applyWorkspaceCommandOptions, "applyWorkspaceCommandOptions")
+	if err != nil {
+		return
+	}
+	record, err := idempotent.begin(key, "ApplyWorkspaceCommand")
+	if err != nil {
+		return
+	}
+
+	wID := *applyWorkspaceCommandOptions.WID
+	if record.ResourceID == "" && !record.SubmittedAt.IsZero() {
+		err = idempotent.findApplyActivity(ctx, record, wID)
+		if err != nil {
+			return
+		}
+	}
+	if record.ResourceID != "" {
+		var activity *WorkspaceActivity
+		activity, response, err = idempotent.GetWorkspaceActivityWithContext(ctx, idempotent.NewGetWorkspaceActivityOptions(wID, record.ResourceID))
+		if err == nil {
+			result = &WorkspaceActivityApplyResult{Activityid: activity.ActionID}
+		}
+		return
+	}
+
+	err = idempotent.submit(record)
+	if err != nil {
+		return
+	}
+	result, response, err = idempotent.SchematicsV1.ApplyWorkspaceCommandWithContext(ctx, applyWorkspaceCommandOptions)
+	if result != nil {
+		idempotent.complete(record, result.Activityid, response, err)
+	} else {
+		idempotent.complete(record, nil, response, err)
+	}
+	return
+}
+
+// findApplyActivity looks for the apply activity of workspace "wID" that was started by the submission of "record"
+// and records its ID in "record".
+func (idempotent *IdempotentSchematicsV1) findApplyActivity(ctx context.Context, record *IdempotencyRecord, wID string) error {
+	pager, err := idempotent.NewWorkspaceActivitiesPager(idempotent.NewListWorkspaceActivitiesOptions(wID))
+	if err != nil {
+		return err
+	}
+	activities, err := pager.SetMaxItems(maxIdempotencyLookupItems).AllWithContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	var match *WorkspaceActivity
+	notBefore := record.SubmittedAt.Add(-idempotencyClockSkew)
+	for i, activity := range activities {
+		if activity.ActionID == nil || activity.PerformedAt == nil || core.StringNilMapper(activity.Name) != "APPLY" {
+			continue
+		}
+		performedAt := time.Time(*activity.PerformedAt)
+		if !performedAt.Before(notBefore) && (match == nil || performedAt.Before(time.Time(*match.PerformedAt))) {
+			match = &activities[i]
+		}
+	}
+	if match == nil {
+		return nil
+	}
+	return idempotent.found(record, *match.ActionID)
+}
+
+// begin returns the record of the call with "key", or a new record if the key has not been used before.
+func (idempotent *IdempotentSchematicsV1) begin(key string, operationID string) (*IdempotencyRecord, error) {
+	if key == "" {
+		return nil, errors.New("idempotency key cannot be empty")
+	}
+	record, err := idempotent.store.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if record == nil {
+		return &IdempotencyRecord{Key: key, OperationID: operationID}, nil
+	}
+	if record.OperationID != operationID {
+		return nil, fmt.Errorf("idempotency key '%s' was already used for operation '%s'", key, record.OperationID)
+	}
+	return record, nil
+}
+
+// found records the ID of the resource created by an earlier submission.
+func (idempotent *IdempotentSchematicsV1) found(record *IdempotencyRecord, resourceID string) error {
+	record.ResourceID = resourceID
+	return idempotent.store.Put(record)
+}
+
+// submit persists the record before its request is submitted.
+func (idempotent *IdempotentSchematicsV1) submit(record *IdempotencyRecord) error {
+	record.SubmittedAt = time.Now()
+	return idempotent.store.Put(record)
+}
+
+// complete updates the record with the outcome of its submission. The record is kept with an unknown outcome unless
+// the request succeeded or was rejected by the service. Errors of the store are ignored at this point: a record
+// left with an unknown outcome is resolved by the lookup when the call is repeated.
+func (idempotent *IdempotentSchematicsV1) complete(record *IdempotencyRecord, resourceID *string, response *core.DetailedResponse, err error) {
+	switch {
+	case err == nil && resourceID != nil:
+		_ = idempotent.found(record, *resourceID)
+	case err != nil && response != nil && response.StatusCode >= 400 && response.StatusCode < 500 &&
+		response.StatusCode != http.StatusRequestTimeout:
+		_ = idempotent.store.Delete(record.Key)
+	}
+}
@@ -0,0 +1,224 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`IdempotentSchematicsV1`, func() {
+	var testServer *httptest.Server
+	var schematicsService *schematicsv1.SchematicsV1
+	var idempotent *schematicsv1.IdempotentSchematicsV1
+	var requests []string
+	var refreshTokens []string
+	var jobTags map[string][]string
+	var workspaceTags map[string][]string
+	var rejectWorkspaces bool
+	var dropResponses int
+
+	BeforeEach(func() {
+		requests = nil
+		refreshTokens = nil
+		jobTags = make(map[string][]string)
+		workspaceTags = make(map[string][]string)
+		rejectWorkspaces = false
+		dropResponses = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			requests = append(requests, req.Method+" "+req.URL.Path)
+			if token := req.Header.Get("refresh_token"); token != "" {
+				refreshTokens = append(refreshTokens, token)
+			}
+			res.Header().Set("Content-type", "application/json")
+			var body struct {
+				Tags []string `json:"tags"`
+			}
+			_ = json.NewDecoder(req.Body).Decode(&body)
+
+			switch {
+			case req.Method == "POST" && req.URL.Path == "/v2/jobs":
+				id := fmt.Sprintf("job-%d", len(jobTags)+1)
+				jobTags[id] = body.Tags
+				fmt.Fprintf(res, `{"id": "%s"}`, id)
+			case req.Method == "GET" && req.URL.Path == "/v2/jobs":
+				var jobs []string
+				for id, tags := range jobTags {
+					tagsJSON, _ := json.Marshal(tags)
+					jobs = append(jobs, fmt.Sprintf(`{"id": "%s", "tags": %s}`, id, tagsJSON))
+				}
+				fmt.Fprintf(res, `{"total_count": %d, "limit": 100, "offset": 0, "jobs": [%s]}`, len(jobs), strings.Join(jobs, ","))
+			case req.Method == "GET" && strings.HasPrefix(req.URL.Path, "/v2/jobs/"):
+				fmt.Fprintf(res, `{"id": "%s"}`, strings.TrimPrefix(req.URL.Path, "/v2/jobs/"))
+			case req.Method == "POST" && req.URL.Path == "/v1/workspaces":
+				if rejectWorkspaces {
+					res.WriteHeader(400)
+					fmt.Fprint(res, `{"message": "invalid template"}`)
+					return
+				}
+				id := fmt.Sprintf("us-south.workspace.ws%d", len(workspaceTags)+1)
+				workspaceTags[id] = body.Tags
+				fmt.Fprintf(res, `{"id": "%s"}`, id)
+			case req.Method == "GET" && req.URL.Path == "/v1/workspaces/us-south.workspace.ws1":
+				fmt.Fprint(res, `{"id": "us-south.workspace.ws1"}`)
+			case req.Method == "PUT" && req.URL.Path == "/v1/workspaces/us-south.workspace.ws1/apply":
+				fmt.Fprint(res, `{"activityid": "activity-2"}`)
+			case req.Method == "GET" && req.URL.Path == "/v1/workspaces/us-south.workspace.ws1/actions":
+				performedAt := time.Now().UTC().Format(time.RFC3339)
+				earlier := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+				fmt.Fprintf(res, `{"actions": [
+					{"action_id": "activity-1", "name": "APPLY", "performed_at": "%s"},
+					{"action_id": "activity-3", "name": "PLAN", "performed_at": "%s"},
+					{"action_id": "activity-2", "name": "APPLY", "performed_at": "%s"}]}`, earlier, performedAt, performedAt)
+			case req.Method == "GET" && req.URL.Path == "/v1/workspaces/us-south.workspace.ws1/actions/activity-2":
+				fmt.Fprint(res, `{"action_id": "activity-2", "name": "APPLY"}`)
+			default:
+				res.WriteHeader(404)
+			}
+		}))
+
+		var serviceErr error
+		schematicsService, serviceErr = schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		// Simulate a network failure after the service has processed the request.
+		schematicsService.Use(func(call *schematicsv1.Call, next schematicsv1.Handler) (*http.Response, error) {
+			response, err := next(call)
+			if err == nil && dropResponses > 0 && call.Request.Method != "GET" {
+				dropResponses--
+				response.Body.Close()
+				return nil, errors.New("connection reset by peer")
+			}
+			return response, err
+		})
+		idempotent = schematicsv1.NewIdempotentSchematicsV1(schematicsService, schematicsv1.NewMemoryIdempotencyStore())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Return the job created by an ambiguous submission`, func() {
+		createJobOptions := schematicsService.NewCreateJobOptions("testString")
+		createJobOptions.SetTags([]string{"team:infra"})
+		dropResponses = 1
+
+		_, _, operationErr := idempotent.IdempotentCreateJob("key-1", createJobOptions)
+		Expect(operationErr).ToNot(BeNil())
+		Expect(jobTags).To(Equal(map[string][]string{"job-1": {"team:infra", "idempotency-key:key-1"}}))
+		Expect(createJobOptions.Tags).To(Equal([]string{"team:infra"}))
+
+		job, _, operationErr := idempotent.IdempotentCreateJob("key-1", createJobOptions)
+		Expect(operationErr).To(BeNil())
+		Expect(*job.ID).To(Equal("job-1"))
+
+		job, _, operationErr = idempotent.IdempotentCreateJob("key-1", createJobOptions)
+		Expect(operationErr).To(BeNil())
+		Expect(*job.ID).To(Equal("job-1"))
+		Expect(requests).To(Equal([]string{"POST /v2/jobs", "GET /v2/jobs", "GET /v2/jobs/job-1", "GET /v2/jobs/job-1"}))
+
+		job, _, operationErr = idempotent.IdempotentCreateJob("key-2", createJobOptions)
+		Expect(operationErr).To(BeNil())
+		Expect(*job.ID).To(Equal("job-2"))
+	})
+	It(`Resubmit a workspace that was rejected by the service`, func() {
+		createWorkspaceOptions := schematicsService.NewCreateWorkspaceOptions()
+		rejectWorkspaces = true
+
+		_, _, operationErr := idempotent.IdempotentCreateWorkspace("key-1", createWorkspaceOptions)
+		Expect(operationErr).ToNot(BeNil())
+
+		rejectWorkspaces = false
+		workspace, _, operationErr := idempotent.IdempotentCreateWorkspace("key-1", createWorkspaceOptions)
+		Expect(operationErr).To(BeNil())
+		Expect(*workspace.ID).To(Equal("us-south.workspace.ws1"))
+		Expect(workspaceTags["us-south.workspace.ws1"]).To(Equal([]string{"idempotency-key:key-1"}))
+		Expect(requests).To(Equal([]string{"POST /v1/workspaces", "POST /v1/workspaces"}))
+
+		_, _, operationErr = idempotent.IdempotentCreateJob("key-1", schematicsService.NewCreateJobOptions("testString"))
+		Expect(operationErr).ToNot(BeNil())
+		Expect(operationErr.Error()).To(ContainSubstring("already used for operation 'CreateWorkspace'"))
+	})
+	It(`Return the apply activity started by an ambiguous submission`, func() {
+		applyWorkspaceCommandOptions := schematicsService.NewApplyWorkspaceCommandOptions("us-south.workspace.ws1", "testString")
+		dropResponses = 1
+
+		_, _, operationErr := idempotent.IdempotentApplyWorkspaceCommand("key-1", applyWorkspaceCommandOptions)
+		Expect(operationErr).ToNot(BeNil())
+
+		result, _, operationErr := idempotent.IdempotentApplyWorkspaceCommand("key-1", applyWorkspaceCommandOptions)
+		Expect(operationErr).To(BeNil())
+		Expect(*result.Activityid).To(Equal("activity-2"))
+		Expect(requests).To(Equal([]string{
+			"PUT /v1/workspaces/us-south.workspace.ws1/apply",
+			"GET /v1/workspaces/us-south.workspace.ws1/actions",
+			"GET /v1/workspaces/us-south.workspace.ws1/actions/activity-2",
+		}))
+	})
+	It(`Use the refresh token of the token provider`, func() {
+		_, _, operationErr := idempotent.IdempotentCreateJob("key-1", &schematicsv1.CreateJobOptions{})
+		Expect(operationErr).ToNot(BeNil())
+		Expect(requests).To(BeEmpty())
+
+		schematicsService.SetTokenProvider(&staticTokenProvider{refreshToken: "provided-token"})
+		job, _, operationErr := idempotent.IdempotentCreateJob("key-1", &schematicsv1.CreateJobOptions{})
+		Expect(operationErr).To(BeNil())
+		Expect(*job.ID).To(Equal("job-1"))
+		result, _, operationErr := idempotent.IdempotentApplyWorkspaceCommand("key-2", &schematicsv1.ApplyWorkspaceCommandOptions{WID: core.StringPtr("us-south.workspace.ws1")})
+		Expect(operationErr).To(BeNil())
+		Expect(*result.Activityid).To(Equal("activity-2"))
+		Expect(refreshTokens).To(Equal([]string{"provided-token", "provided-token"}))
+	})
+	It(`Persist records in files`, func() {
+		dir, err := os.MkdirTemp("", "idempotency")
+		Expect(err).To(BeNil())
+		defer os.RemoveAll(dir)
+		store, err := schematicsv1.NewFileIdempotencyStore(filepath.Join(dir, "records"))
+		Expect(err).To(BeNil())
+
+		record, err := store.Get("key/1")
+		Expect(err).To(BeNil())
+		Expect(record).To(BeNil())
+
+		submittedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+		Expect(store.Put(&schematicsv1.IdempotencyRecord{Key: "key/1", OperationID: "CreateJob", SubmittedAt: submittedAt})).To(Succeed())
+		record, err = store.Get("key/1")
+		Expect(err).To(BeNil())
+		Expect(*record).To(Equal(schematicsv1.IdempotencyRecord{Key: "key/1", OperationID: "CreateJob", SubmittedAt: submittedAt}))
+
+		Expect(store.Delete("key/1")).To(Succeed())
+		Expect(store.Delete("key/1")).To(Succeed())
+		record, err = store.Get("key/1")
+		Expect(err).To(BeNil())
+		Expect(record).To(BeNil())
+	})
+})
@@ -0,0 +1,419 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Operation : An invocation of a service method of a SchematicsV1 instance, which sends its request once for each
+// attempt. Interceptors use it to keep state across the attempts of the operation and to be notified of its end.
+type Operation struct {
+	// The operationId of the service method, e.g. "GetWorkspace".
+	ID string
+
+	attempts atomic.Int32
+
+	mutex  sync.Mutex
+	values map[any]any
+	onEnd  []func(err error)
+
+	// The cancellation of the context of the request of the operation, and the error that aborted it, see abort.
+	cancel  context.CancelCauseFunc
+	aborted error
+}
+
+// Value returns the value associated with "key" by SetValue, or nil.
+func (operation *Operation) Value(key any) any {
+	operation.mutex.Lock()
+	defer operation.mutex.Unlock()
+	return operation.values[key]
+}
+
+// SetValue associates "value" with "key" for the remaining attempts of the operation.
+func (operation *Operation) SetValue(key any, value any) {
+	operation.mutex.Lock()
+	defer operation.mutex.Unlock()
+	if operation.values == nil {
+		operation.values = make(map[any]any)
+	}
+	operation.values[key] = value
+}
+
+// OnEnd registers a function that is called once the last attempt of the operation has completed, with the error
+// of the operation: nil if it received a successful response. Functions are called in the reverse order of their
+// registration.
+func (operation *Operation) OnEnd(f func(err error)) {
+	operation.mutex.Lock()
+	defer operation.mutex.Unlock()
+	operation.onEnd = append(operation.onEnd, f)
+}
+
+// end calls the functions registered with OnEnd.
+func (operation *Operation) end(err error) {
+	operation.mutex.Lock()
+	onEnd := operation.onEnd
+	operation.onEnd = nil
+	operation.mutex.Unlock()
+
+	for i := len(onEnd) - 1; i >= 0; i-- {
+		onEnd[i](err)
+	}
+}
+
+// abort ends the operation with "err" without further attempts: the context of its request is canceled, so
+// automatic retries stop, and the operation fails with "err" rather than with the error of the attempt.
+func (operation *Operation) abort(err error) {
+	operation.mutex.Lock()
+	defer operation.mutex.Unlock()
+	if operation.aborted == nil {
+		operation.aborted = err
+		if operation.cancel != nil {
+			operation.cancel(err)
+		}
+	}
+}
+
+// abortedWith returns the error that aborted the operation, or nil.
+func (operation *Operation) abortedWith() error {
+	operation.mutex.Lock()
+	defer operation.mutex.Unlock()
+	return operation.aborted
+}
+
+// operationContextKey is the key of the operation in the context of the requests that it issues.
+type operationContextKey struct{}
+
+// withOperation returns a copy of "ctx" that identifies the requests sent with it as issued by a new operation
+// of the service method "operationID". The service methods build their requests with such a context, so the
+// operation is known to the transport without being sent to the server.
+func withOperation(ctx context.Context, operationID string) context.Context {
+	return context.WithValue(ctx, operationContextKey{}, &Operation{ID: operationID})
+}
+
+// operationFromContext returns the operation that issued the request with context "ctx", or nil if unknown.
+func operationFromContext(ctx context.Context) *Operation {
+	operation, _ := ctx.Value(operationContextKey{}).(*Operation)
+	return operation
+}
+
+// request sends "request" like Service.Request, with the headers carried by its context (see WithHeaders), then
+// ends the operation that issued it. The response of a dry-run instance carries the rendered request.
+func (schematics *SchematicsV1) request(request *http.Request, result interface{}) (response *core.DetailedResponse, err error) {
+	addContextHeaders(request)
+	operation := operationFromContext(request.Context())
+	if operation != nil {
+		ctx, cancel := context.WithCancelCause(request.Context())
+		defer cancel(nil)
+		operation.mutex.Lock()
+		operation.cancel = cancel
+		operation.mutex.Unlock()
+		request = request.WithContext(ctx)
+	}
+
+	response, err = schematics.Service.Request(request, result)
+	if operation != nil {
+		if aborted := operation.abortedWith(); aborted != nil {
+			err = aborted
+		}
+		if rendered, ok := operation.Value(renderedRequestKey{}).(*RenderedRequest); ok && err == nil {
+			response.Result = rendered
+		}
+		operation.end(err)
+	}
+	return
+}
+
+// Call : A single HTTP request sent on behalf of an operation, as seen by the interceptor chain.
+type Call struct {
+	// The operationId of the operation that issued the request (e.g. "GetWorkspace"), or "" for requests that
+	// were not issued by a service method of the instance.
+	OperationID string
+
+	// The operation that issued the request, shared by its attempts, or nil for requests that were not issued by a
+	// service method of the instance.
+	Operation *Operation
+
+	// The attempt number of the request, starting at 1. It is greater than 1 when automatic retries
+	// are enabled and the request is being resent.
+	Attempt int
+
+	// The outgoing request: a copy of the request built by the service method, so interceptors may modify it in
+	// place (e.g. to add headers) or replace it without affecting the other attempts.
+	Request *http.Request
+}
+
+// resourceTypes maps the path segments that name a collection of Schematics resources to the type of the
+// resources in the collection.
+var resourceTypes = map[string]string{
+	"workspaces":      "workspace",
+	"template_data":   "template",
+	"runtime_data":    "template",
+	"actions":         "action",
+	"jobs":            "job",
+	"workspace_jobs":  "workspace_job",
+	"blueprints":      "blueprint",
+	"inventories":     "inventory",
+	"resources_query": "resource_query",
+	"agents":          "agent",
+	"policies":        "policy",
+}
+
+// bodyResourceFields maps the top-level fields of JSON request bodies that hold the ID of a resource to the type
+// of the resource.
+var bodyResourceFields = map[string]string{
+	"workspace_id": "workspace",
+	"action_id":    "action",
+	"job_id":       "job",
+	"blueprint_id": "blueprint",
+	"agent_id":     "agent",
+}
+
+// commandObjectTypes maps the command objects of jobs to the type of the resource named by "command_object_id".
+var commandObjectTypes = map[string]string{
+	"workspace":   "workspace",
+	"action":      "action",
+	"blueprint":   "blueprint",
+	"environment": "blueprint",
+}
+
+// ResourceIDs returns the IDs of the resources addressed by the request, keyed by resource type: "workspace",
+// "template", "activity", "action", "job", "workspace_job", "blueprint", "inventory", "resource_query", "agent"
+// or "policy". The IDs are taken from the request path, then from the top-level fields of a JSON request body,
+// e.g. the command_object_id of CreateJob.
+func (call *Call) ResourceIDs() map[string]string {
+	resourceIDs := make(map[string]string)
+	segments := strings.Split(strings.Trim(call.Request.URL.Path, "/"), "/")
+	for i := 0; i+1 < len(segments); i++ {
+		resourceType, ok := resourceTypes[segments[i]]
+		if !ok || segments[i+1] == "" || (resourceType == "agent" && segments[i+1] == "versions") {
+			continue
+		}
+		// Workspace actions are the activities that were run on the workspace.
+		if _, nested := resourceIDs["workspace"]; nested && resourceType == "action" {
+			resourceType = "activity"
+		}
+		resourceIDs[resourceType] = segments[i+1]
+		i++
+	}
+
+	body := call.jsonBody()
+	addBodyResourceID := func(resourceType string, value interface{}) {
+		if resourceID, ok := value.(string); ok && resourceID != "" && resourceIDs[resourceType] == "" {
+			resourceIDs[resourceType] = resourceID
+		}
+	}
+	for field, resourceType := range bodyResourceFields {
+		addBodyResourceID(resourceType, body[field])
+	}
+	if commandObject, ok := body["command_object"].(string); ok && commandObjectTypes[commandObject] != "" {
+		addBodyResourceID(commandObjectTypes[commandObject], body["command_object_id"])
+	}
+	return resourceIDs
+}
+
+// jsonBody returns the request body decoded as a JSON object, or nil if the request has no JSON object body that
+// can be read again.
+func (call *Call) jsonBody() map[string]interface{} {
+	mediaType, _, _ := mime.ParseMediaType(call.Request.Header.Get("Content-Type"))
+	if call.Request.GetBody == nil || !strings.HasSuffix(mediaType, "json") {
+		return nil
+	}
+	body, err := call.Request.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer body.Close()
+
+	var reader io.Reader = body
+	if call.Request.Header.Get("Content-Encoding") == "gzip" {
+		if reader, err = gzip.NewReader(body); err != nil {
+			return nil
+		}
+	}
+	var object map[string]interface{}
+	if err := json.NewDecoder(reader).Decode(&object); err != nil {
+		return nil
+	}
+	return object
+}
+
+// Handler sends the request of a call and returns the response.
+type Handler func(call *Call) (*http.Response, error)
+
+// Interceptor intercepts the requests sent by a SchematicsV1 instance. An interceptor typically inspects or
+// modifies the call, invokes "next" to continue along the chain, and then inspects the response.
+// An interceptor may also return a response or error without invoking "next".
+type Interceptor func(call *Call, next Handler) (*http.Response, error)
+
+// pipeline is the http.RoundTripper that runs the interceptor chain of a SchematicsV1 instance before
+// handing the request to the transport that it wraps.
+type pipeline struct {
+	next http.RoundTripper
+
+	mutex        sync.RWMutex
+	interceptors []Interceptor
+}
+
+// installMutex serializes the installation of pipelines on HTTP clients.
+var installMutex sync.Mutex
+
+// Use appends interceptors to the interceptor chain of the instance. Interceptors run in the order in which
+// they were added, once for each HTTP request, including the requests resent by automatic retries.
+//
+// The first call installs the chain on a copy of the HTTP client of the instance, with a copy of its transport, so
+// the client and transport that it was configured with, which may be shared, e.g. http.DefaultClient, are left
+// unchanged. The chain is shared with the clones made afterwards, and replacing the HTTP client with SetHTTPClient
+// keeps it. Use the DisableSSLVerification and IsSSLDisabled methods of the instance rather than those of Service,
+// which do not see the transport behind the chain.
+func (schematics *SchematicsV1) Use(interceptors ...Interceptor) {
+	installed := schematics.pipeline()
+
+	installed.mutex.Lock()
+	defer installed.mutex.Unlock()
+	chain := make([]Interceptor, 0, len(installed.interceptors)+len(interceptors))
+	chain = append(chain, installed.interceptors...)
+	installed.interceptors = append(chain, interceptors...)
+}
+
+// SetHTTPClient sets the http.Client instance used to invoke individual HTTP requests. If the instance has an
+// interceptor chain, it is kept: the instance uses a copy of "client" whose transport runs a copy of the chain
+// before handing the requests to a copy of the transport of "client". "client" itself is left unchanged.
+func (schematics *SchematicsV1) SetHTTPClient(client *http.Client) {
+	installMutex.Lock()
+	defer installMutex.Unlock()
+
+	if installed, ok := schematics.Service.GetHTTPClient().Transport.(*pipeline); ok && client != nil {
+		if _, ok := client.Transport.(*pipeline); !ok {
+			installed.mutex.RLock()
+			interceptors := installed.interceptors
+			installed.mutex.RUnlock()
+
+			client, _ = newPipelineClient(client, interceptors)
+		}
+	}
+	schematics.Service.SetHTTPClient(client)
+}
+
+// DisableSSLVerification configures the instance to skip the verification of server certificates and hostnames,
+// including when its HTTP client runs an interceptor chain. This makes the client susceptible to
+// "man-in-the-middle" attacks, and should be used only for testing or in secure environments.
+func (schematics *SchematicsV1) DisableSSLVerification() {
+	installMutex.Lock()
+	defer installMutex.Unlock()
+
+	installed, ok := schematics.Service.GetHTTPClient().Transport.(*pipeline)
+	if !ok {
+		schematics.Service.DisableSSLVerification()
+		return
+	}
+	// The transport behind the chain is a copy owned by the instance, so it can be changed in place.
+	if transport, ok := installed.next.(*http.Transport); ok && transport != nil {
+		if transport.TLSClientConfig == nil {
+			transport.TLSClientConfig = &tls.Config{} // #nosec G402
+		}
+		transport.TLSClientConfig.InsecureSkipVerify = true // #nosec G402
+	}
+}
+
+// IsSSLDisabled returns true if and only if the HTTP client of the instance is configured to skip the verification
+// of server SSL certificates, including when it runs an interceptor chain.
+func (schematics *SchematicsV1) IsSSLDisabled() bool {
+	installMutex.Lock()
+	defer installMutex.Unlock()
+
+	installed, ok := schematics.Service.GetHTTPClient().Transport.(*pipeline)
+	if !ok {
+		return schematics.Service.IsSSLDisabled()
+	}
+	transport, ok := installed.next.(*http.Transport)
+	return ok && transport != nil && transport.TLSClientConfig != nil && transport.TLSClientConfig.InsecureSkipVerify
+}
+
+// pipeline returns the pipeline installed on the HTTP client of the instance, installing one if needed.
+func (schematics *SchematicsV1) pipeline() *pipeline {
+	installMutex.Lock()
+	defer installMutex.Unlock()
+
+	client := schematics.Service.GetHTTPClient()
+	if installed, ok := client.Transport.(*pipeline); ok {
+		return installed
+	}
+	copied, installed := newPipelineClient(client, nil)
+	schematics.Service.SetHTTPClient(copied)
+	return installed
+}
+
+// newPipelineClient returns a copy of "client" whose transport runs "interceptors" before handing the requests to
+// a copy of the transport of "client", or of http.DefaultTransport if it has none. Transports other than
+// *http.Transport cannot be copied, and are used as they are.
+func newPipelineClient(client *http.Client, interceptors []Interceptor) (*http.Client, *pipeline) {
+	next := client.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if transport, ok := next.(*http.Transport); ok && transport != nil {
+		next = transport.Clone()
+	}
+	installed := &pipeline{next: next, interceptors: interceptors}
+	copied := *client
+	copied.Transport = installed
+	return &copied, installed
+}
+
+// RoundTrip runs the interceptor chain for "req".
+func (pipeline *pipeline) RoundTrip(req *http.Request) (*http.Response, error) {
+	call := &Call{
+		Attempt: 1,
+		Request: req.Clone(req.Context()),
+	}
+	// The retry logic resends the same request, so the attempts are counted by the operation in its context.
+	if operation := operationFromContext(req.Context()); operation != nil {
+		call.OperationID = operation.ID
+		call.Operation = operation
+		call.Attempt = int(operation.attempts.Add(1))
+	}
+
+	pipeline.mutex.RLock()
+	interceptors := pipeline.interceptors
+	pipeline.mutex.RUnlock()
+
+	handler := pipeline.send
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], handler
+		handler = func(call *Call) (*http.Response, error) {
+			return interceptor(call, next)
+		}
+	}
+	return handler(call)
+}
+
+// send hands the request of "call" to the wrapped transport.
+func (pipeline *pipeline) send(call *Call) (*http.Response, error) {
+	return pipeline.next.RoundTrip(call.Request)
+}
@@ -0,0 +1,254 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Interceptors`, func() {
+	var testServer *httptest.Server
+	var schematicsService *schematicsv1.SchematicsV1
+	var failures int
+	var receivedHeaders http.Header
+
+	BeforeEach(func() {
+		failures = 0
+		receivedHeaders = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			receivedHeaders = req.Header
+			res.Header().Set("Content-type", "application/json")
+			if failures > 0 {
+				failures--
+				res.WriteHeader(503)
+				fmt.Fprint(res, `{"message": "unavailable"}`)
+				return
+			}
+			res.WriteHeader(200)
+			fmt.Fprint(res, `{"id": "testString"}`)
+		}))
+
+		var serviceErr error
+		schematicsService, serviceErr = schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Run interceptors in order`, func() {
+		var events []string
+		record := func(name string) schematicsv1.Interceptor {
+			return func(call *schematicsv1.Call, next schematicsv1.Handler) (*http.Response, error) {
+				events = append(events, name+" before "+call.OperationID)
+				response, err := next(call)
+				events = append(events, fmt.Sprintf("%s after %d", name, response.StatusCode))
+				return response, err
+			}
+		}
+		schematicsService.Use(record("first"))
+		schematicsService.Use(record("second"))
+
+		_, _, operationErr := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("testString"))
+		Expect(operationErr).To(BeNil())
+		Expect(events).To(Equal([]string{
+			"first before GetWorkspace",
+			"second before GetWorkspace",
+			"second after 200",
+			"first after 200",
+		}))
+	})
+	It(`Inject headers`, func() {
+		schematicsService.Use(func(call *schematicsv1.Call, next schematicsv1.Handler) (*http.Response, error) {
+			call.Request.Header.Set("X-Audit-User", "auditor")
+			return next(call)
+		})
+
+		_, _, operationErr := schematicsService.CreateWorkspace(schematicsService.NewCreateWorkspaceOptions())
+		Expect(operationErr).To(BeNil())
+		Expect(receivedHeaders.Get("X-Audit-User")).To(Equal("auditor"))
+	})
+	It(`Send neither the operation nor the attempt number to the server`, func() {
+		var operations []string
+		schematicsService.Use(func(call *schematicsv1.Call, next schematicsv1.Handler) (*http.Response, error) {
+			operations = append(operations, fmt.Sprintf("%s#%d", call.OperationID, call.Attempt))
+			call.Request.Header.Set("X-Attempt", fmt.Sprint(call.Attempt))
+			return next(call)
+		})
+		schematicsService.EnableRetries(3, 10*time.Millisecond)
+		failures = 1
+
+		_, _, operationErr := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("testString"))
+		Expect(operationErr).To(BeNil())
+		Expect(operations).To(Equal([]string{"GetWorkspace#1", "GetWorkspace#2"}))
+		Expect(receivedHeaders.Get("X-Attempt")).To(Equal("2"))
+		for headerName := range receivedHeaders {
+			Expect(headerName).ToNot(HavePrefix("X-Sdk-"))
+		}
+	})
+	It(`Short-circuit the chain`, func() {
+		schematicsService.Use(func(call *schematicsv1.Call, next schematicsv1.Handler) (*http.Response, error) {
+			return nil, errors.New("blocked by interceptor")
+		})
+
+		_, _, operationErr := schematicsService.DeleteWorkspace(schematicsService.NewDeleteWorkspaceOptions("testString", "testString"))
+		Expect(operationErr).ToNot(BeNil())
+		Expect(operationErr.Error()).To(ContainSubstring("blocked by interceptor"))
+		Expect(receivedHeaders).To(BeNil())
+	})
+	It(`Report the attempt number of retried requests`, func() {
+		var attempts []int
+		schematicsService.Use(func(call *schematicsv1.Call, next schematicsv1.Handler) (*http.Response, error) {
+			attempts = append(attempts, call.Attempt)
+			return next(call)
+		})
+		schematicsService.EnableRetries(3, 10*time.Millisecond)
+		failures = 2
+
+		_, _, operationErr := schematicsService.GetJob(schematicsService.NewGetJobOptions("testString"))
+		Expect(operationErr).To(BeNil())
+		Expect(attempts).To(Equal([]int{1, 2, 3}))
+
+		attempts = nil
+		_, _, operationErr = schematicsService.GetJob(schematicsService.NewGetJobOptions("testString"))
+		Expect(operationErr).To(BeNil())
+		Expect(attempts).To(Equal([]int{1}))
+	})
+	It(`Keep state across the attempts of an operation`, func() {
+		var events []string
+		schematicsService.Use(func(call *schematicsv1.Call, next schematicsv1.Handler) (*http.Response, error) {
+			if call.Operation.Value("started") == nil {
+				call.Operation.SetValue("started", true)
+				call.Operation.OnEnd(func(err error) {
+					events = append(events, fmt.Sprintf("end %s: %v", call.Operation.ID, err))
+				})
+			}
+			events = append(events, fmt.Sprintf("attempt %d", call.Attempt))
+			return next(call)
+		})
+		schematicsService.EnableRetries(3, 10*time.Millisecond)
+		failures = 1
+
+		_, _, operationErr := schematicsService.GetInventory(schematicsService.NewGetInventoryOptions("testString"))
+		Expect(operationErr).To(BeNil())
+		Expect(events).To(Equal([]string{"attempt 1", "attempt 2", "end GetInventory: <nil>"}))
+	})
+	It(`Report the resource IDs of the request`, func() {
+		var resourceIDs []map[string]string
+		schematicsService.Use(func(call *schematicsv1.Call, next schematicsv1.Handler) (*http.Response, error) {
+			resourceIDs = append(resourceIDs, call.ResourceIDs())
+			return next(call)
+		})
+
+		_, _, operationErr := schematicsService.GetWorkspaceActivity(schematicsService.NewGetWorkspaceActivityOptions("us-south.workspace.foo.abc123", "activity-1"))
+		Expect(operationErr).To(BeNil())
+		_, _, operationErr = schematicsService.GetJobFiles(schematicsService.NewGetJobFilesOptions("job-1", "state_file"))
+		Expect(operationErr).To(BeNil())
+		_, _, operationErr = schematicsService.GetAgentVersions(schematicsService.NewGetAgentVersionsOptions())
+		Expect(operationErr).To(BeNil())
+		createJobOptions := schematicsService.NewCreateJobOptions("refresh-token")
+		createJobOptions.SetCommandObject("workspace").SetCommandObjectID("us-south.workspace.bar.def456")
+		_, _, operationErr = schematicsService.CreateJob(createJobOptions)
+		Expect(operationErr).To(BeNil())
+
+		Expect(resourceIDs).To(Equal([]map[string]string{
+			{"workspace": "us-south.workspace.foo.abc123", "activity": "activity-1"},
+			{"job": "job-1"},
+			{},
+			{"workspace": "us-south.workspace.bar.def456"},
+		}))
+	})
+	It(`Share interceptors with clones and keep them when the HTTP client is replaced`, func() {
+		var operations []string
+		schematicsService.Use(func(call *schematicsv1.Call, next schematicsv1.Handler) (*http.Response, error) {
+			operations = append(operations, call.OperationID)
+			return next(call)
+		})
+
+		clone := schematicsService.Clone()
+		_, _, operationErr := clone.GetAction(clone.NewGetActionOptions("testString"))
+		Expect(operationErr).To(BeNil())
+
+		client := &http.Client{}
+		schematicsService.SetHTTPClient(client)
+		Expect(client.Transport).To(BeNil())
+		_, _, operationErr = schematicsService.GetBlueprint(schematicsService.NewGetBlueprintOptions("testString"))
+		Expect(operationErr).To(BeNil())
+		Expect(operations).To(Equal([]string{"GetAction", "GetBlueprint"}))
+	})
+	It(`Leave a shared HTTP client and its transport unchanged`, func() {
+		defaultTransport := http.DefaultTransport.(*http.Transport)
+		var defaultTLSConfig *tls.Config
+		if defaultTransport.TLSClientConfig != nil {
+			defaultTLSConfig = defaultTransport.TLSClientConfig.Clone()
+		}
+
+		schematicsService.SetHTTPClient(http.DefaultClient)
+		schematicsService.Use(func(call *schematicsv1.Call, next schematicsv1.Handler) (*http.Response, error) {
+			return next(call)
+		})
+		Expect(http.DefaultClient.Transport).To(BeNil())
+		Expect(schematicsService.IsSSLDisabled()).To(BeFalse())
+
+		schematicsService.DisableSSLVerification()
+		Expect(schematicsService.IsSSLDisabled()).To(BeTrue())
+		Expect(http.DefaultClient.Transport).To(BeNil())
+		Expect(defaultTransport.TLSClientConfig).To(Equal(defaultTLSConfig))
+		_, _, operationErr := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("testString"))
+		Expect(operationErr).To(BeNil())
+	})
+	It(`Keep the SSL verification setting when the chain is installed`, func() {
+		schematicsService.Service.DisableSSLVerification()
+		Expect(schematicsService.IsSSLDisabled()).To(BeTrue())
+		schematicsService.Use(func(call *schematicsv1.Call, next schematicsv1.Handler) (*http.Response, error) {
+			return next(call)
+		})
+		Expect(schematicsService.IsSSLDisabled()).To(BeTrue())
+		Expect(schematicsService.Clone().IsSSLDisabled()).To(BeTrue())
+	})
+	It(`Disable SSL verification of a client that runs interceptors`, func() {
+		tlsServer := httptest.NewTLSServer(testServer.Config.Handler)
+		defer tlsServer.Close()
+		Expect(schematicsService.SetServiceURL(tlsServer.URL)).To(BeNil())
+		schematicsService.Use(func(call *schematicsv1.Call, next schematicsv1.Handler) (*http.Response, error) {
+			return next(call)
+		})
+
+		_, _, operationErr := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("testString"))
+		Expect(operationErr).ToNot(BeNil())
+
+		schematicsService.DisableSSLVerification()
+		_, _, operationErr = schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("testString"))
+		Expect(operationErr).To(BeNil())
+		Expect(schematicsService.IsSSLDisabled()).To(BeTrue())
+	})
+})
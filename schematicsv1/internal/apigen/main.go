@@ -0,0 +1,456 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command apigen generates the SchematicsV1API interface and the schematicstest.FakeSchematicsV1 test double from
+// the operations and options constructors of the generated SchematicsV1 service.
+//
+// It is run by "go generate" in the schematicsv1 directory, after each regeneration of schematics_v1.go:
+//
+//	go generate ./schematicsv1
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// The files read and written by the generator, relative to the schematicsv1 directory.
+const (
+	serviceFile   = "schematics_v1.go"
+	interfaceFile = "schematics_v1_api.go"
+	fakeFile      = "schematicstest/fake_schematics_v1.go"
+)
+
+// The import path of the schematicsv1 package.
+const servicePackagePath = "github.com/IBM/schematics-go-sdk/schematicsv1"
+
+// param is a parameter or a result of a method.
+type param struct {
+	Name          string
+	Type          string
+	QualifiedType string
+}
+
+// method is a method of SchematicsV1 exposed by the interface.
+type method struct {
+	Name    string
+	Summary string
+	Params  []param
+	Results []param
+}
+
+// service holds the methods of SchematicsV1, in the order of their declaration.
+type service struct {
+	Header       string
+	Operations   []method
+	Constructors []method
+	imports      map[string]string
+	used         map[string]bool
+}
+
+func main() {
+	dir := "."
+	if len(os.Args) > 1 {
+		dir = os.Args[1]
+	}
+	files, err := generate(dir)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "apigen:", err)
+		os.Exit(1)
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), content, 0644); err != nil {
+			fmt.Fprintln(os.Stderr, "apigen:", err)
+			os.Exit(1)
+		}
+	}
+}
+
+// generate returns the content of the generated files, keyed by their path relative to "dir", the schematicsv1
+// directory.
+func generate(dir string) (map[string][]byte, error) {
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, filepath.Join(dir, serviceFile), nil, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	service, err := parseService(fileSet, file)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string][]byte)
+	for name, tmpl := range map[string]*template.Template{interfaceFile: interfaceTemplate, fakeFile: fakeTemplate} {
+		var buffer bytes.Buffer
+		if err := tmpl.Execute(&buffer, service); err != nil {
+			return nil, err
+		}
+		content, err := format.Source(buffer.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("formatting %s: %w", name, err)
+		}
+		files[name] = content
+	}
+	return files, nil
+}
+
+// parseService collects the operations and the options constructors of SchematicsV1 declared in "file".
+func parseService(fileSet *token.FileSet, file *ast.File) (*service, error) {
+	service := &service{
+		Header:  licenseHeader(file),
+		imports: make(map[string]string),
+		used:    make(map[string]bool),
+	}
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		name := path[strings.LastIndex(path, "/")+1:]
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		service.imports[name] = path
+	}
+
+	declarations := make(map[string]*ast.FuncDecl)
+	var names []string
+	for _, decl := range file.Decls {
+		function, ok := decl.(*ast.FuncDecl)
+		if !ok || !isServiceReceiver(function.Recv) || !function.Name.IsExported() {
+			continue
+		}
+		declarations[function.Name.Name] = function
+		names = append(names, function.Name.Name)
+	}
+
+	for _, name := range names {
+		function := declarations[name]
+		switch {
+		case strings.HasPrefix(name, "New") && len(function.Recv.List[0].Names) == 0:
+			constructor, err := service.method(fileSet, name, function, function)
+			if err != nil {
+				return nil, err
+			}
+			service.Constructors = append(service.Constructors, constructor)
+		case strings.HasSuffix(name, "WithContext"):
+			// Operations are the methods declared both with and without a context.
+			base := declarations[strings.TrimSuffix(name, "WithContext")]
+			if base == nil {
+				continue
+			}
+			operation, err := service.method(fileSet, base.Name.Name, base, function)
+			if err != nil {
+				return nil, err
+			}
+			service.Operations = append(service.Operations, operation)
+		}
+	}
+	if len(service.Operations) == 0 {
+		return nil, fmt.Errorf("no operations found in %s", serviceFile)
+	}
+	return service, nil
+}
+
+// method describes the method "name", documented by "doc" and with the signature of "signature".
+func (service *service) method(fileSet *token.FileSet, name string, doc *ast.FuncDecl, signature *ast.FuncDecl) (method method, err error) {
+	method.Name = name
+	if doc.Doc != nil {
+		method.Summary = strings.SplitN(doc.Doc.Text(), "\n", 2)[0]
+	}
+	if method.Params, err = service.fields(fileSet, signature.Type.Params); err != nil {
+		return
+	}
+	method.Results, err = service.fields(fileSet, signature.Type.Results)
+	return
+}
+
+// fields describes the parameters or the results in "list".
+func (service *service) fields(fileSet *token.FileSet, list *ast.FieldList) ([]param, error) {
+	var params []param
+	if list == nil {
+		return params, nil
+	}
+	for _, field := range list.List {
+		var buffer bytes.Buffer
+		if err := printer.Fprint(&buffer, fileSet, field.Type); err != nil {
+			return nil, err
+		}
+		unqualified := buffer.String()
+		qualified, err := service.qualify(unqualified)
+		if err != nil {
+			return nil, err
+		}
+		names := field.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{{Name: ""}}
+		}
+		for _, name := range names {
+			params = append(params, param{Name: name.Name, Type: unqualified, QualifiedType: qualified})
+		}
+	}
+	return params, nil
+}
+
+// qualify returns "typ", a type expression of the schematicsv1 package, as seen from another package. It also
+// records the packages used by the type.
+func (service *service) qualify(typ string) (string, error) {
+	expr, err := parser.ParseExpr(typ)
+	if err != nil {
+		return "", err
+	}
+	ast.Inspect(expr, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.SelectorExpr:
+			if pkg, ok := node.X.(*ast.Ident); ok {
+				service.used[pkg.Name] = true
+			}
+			return false
+		case *ast.Ident:
+			if node.IsExported() {
+				node.Name = "schematicsv1." + node.Name
+			}
+		}
+		return true
+	})
+	var buffer bytes.Buffer
+	if err := printer.Fprint(&buffer, token.NewFileSet(), expr); err != nil {
+		return "", err
+	}
+	return buffer.String(), nil
+}
+
+// Imports returns the import specs of the packages used by the signatures of the methods and of "extra", if not
+// empty, grouped into standard and other packages and sorted by path. An empty spec separates the groups.
+func (service *service) Imports(extra string) []string {
+	paths := make(map[string]string)
+	for name := range service.used {
+		path := service.imports[name]
+		if path[strings.LastIndex(path, "/")+1:] != name {
+			paths[path] = name + " " + strconv.Quote(path)
+		} else {
+			paths[path] = strconv.Quote(path)
+		}
+	}
+	if extra != "" {
+		paths[extra] = strconv.Quote(extra)
+	}
+
+	var standard, others []string
+	for path := range paths {
+		if strings.Contains(strings.Split(path, "/")[0], ".") {
+			others = append(others, path)
+		} else {
+			standard = append(standard, path)
+		}
+	}
+	sort.Strings(standard)
+	sort.Strings(others)
+
+	var specs []string
+	for _, path := range standard {
+		specs = append(specs, paths[path])
+	}
+	if len(standard) > 0 && len(others) > 0 {
+		specs = append(specs, "")
+	}
+	for _, path := range others {
+		specs = append(specs, paths[path])
+	}
+	return specs
+}
+
+// isServiceReceiver returns true if "recv" is a *SchematicsV1 receiver.
+func isServiceReceiver(recv *ast.FieldList) bool {
+	if recv == nil || len(recv.List) != 1 {
+		return false
+	}
+	star, ok := recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	ident, ok := star.X.(*ast.Ident)
+	return ok && ident.Name == "SchematicsV1"
+}
+
+// licenseHeader returns the license comment at the top of "file".
+func licenseHeader(file *ast.File) string {
+	if len(file.Comments) == 0 || file.Comments[0].Pos() > file.Package {
+		return ""
+	}
+	var lines []string
+	for _, comment := range file.Comments[0].List {
+		lines = append(lines, comment.Text)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Template functions.
+var funcs = template.FuncMap{
+	// params returns the parameters of a method, as declared in the schematicsv1 package or qualified for another.
+	"params": func(params []param, qualified bool) string {
+		var parts []string
+		for _, param := range params {
+			typ := param.Type
+			if qualified {
+				typ = param.QualifiedType
+			}
+			parts = append(parts, strings.TrimSpace(param.Name+" "+typ))
+		}
+		return strings.Join(parts, ", ")
+	},
+	// types returns the qualified types of parameters, without their names.
+	"types": func(params []param) string {
+		var parts []string
+		for _, param := range params {
+			parts = append(parts, param.QualifiedType)
+		}
+		return strings.Join(parts, ", ")
+	},
+	// names returns the names of parameters.
+	"names": func(params []param) string {
+		var parts []string
+		for _, param := range params {
+			parts = append(parts, param.Name)
+		}
+		return strings.Join(parts, ", ")
+	},
+	// tail returns the parameters after the context.
+	"tail": func(params []param) []param {
+		return params[1:]
+	},
+}
+
+var interfaceTemplate = template.Must(template.New(interfaceFile).Funcs(funcs).Parse(`{{.Header}}
+
+// Code generated by apigen from schematics_v1.go. DO NOT EDIT.
+
+//go:{{"generate"}} go run ./internal/apigen
+
+package schematicsv1
+
+import (
+{{- range .Imports ""}}
+	{{.}}
+{{- end}}
+)
+
+// SchematicsV1API : The operations of the Schematics service and the constructors of their options, implemented by
+// SchematicsV1. Code that depends on SchematicsV1API rather than on SchematicsV1 can be unit tested with
+// schematicstest.FakeSchematicsV1.
+type SchematicsV1API interface {
+{{- range .Operations}}
+	// {{.Summary}}
+	{{.Name}}({{params (tail .Params) false}}) ({{params .Results false}})
+	{{.Name}}WithContext({{params .Params false}}) ({{params .Results false}})
+{{end}}
+{{- range .Constructors}}
+	// {{.Summary}}
+	{{.Name}}({{params .Params false}}) ({{params .Results false}})
+{{- end}}
+}
+
+var _ SchematicsV1API = (*SchematicsV1)(nil)
+`))
+
+var fakeTemplate = template.Must(template.New(fakeFile).Funcs(funcs).Parse(`{{.Header}}
+
+// Code generated by apigen from schematics_v1.go. DO NOT EDIT.
+
+package schematicstest
+
+import (
+{{- range .Imports "` + servicePackagePath + `"}}
+	{{.}}
+{{- end}}
+)
+
+// FakeSchematicsV1 : A configurable test double of schematicsv1.SchematicsV1API, which records its calls.
+//
+// The behaviour of an operation is set by its Stub field, or by its Returns method; both variants of the operation,
+// with and without a context, call the same stub. Operations without a stub return an error wrapping ErrNotStubbed.
+// Stub fields must be set before the fake is used concurrently; the Returns methods can be called at any time.
+// The options constructors behave as those of SchematicsV1.
+type FakeSchematicsV1 struct {
+	calls
+{{range .Operations}}
+	// {{.Name}}Stub : The implementation of {{.Name}} and {{.Name}}WithContext.
+	{{.Name}}Stub func({{types .Params}}) ({{types .Results}})
+{{end -}}
+}
+
+var _ schematicsv1.SchematicsV1API = (*FakeSchematicsV1)(nil)
+{{range .Operations}}
+// {{.Name}} : Calls {{.Name}}Stub with a background context.
+func (fake *FakeSchematicsV1) {{.Name}}({{params (tail .Params) true}}) ({{params .Results true}}) {
+	return fake.{{.Name}}WithContext(context.Background(), {{names (tail .Params)}})
+}
+
+// {{.Name}}WithContext : Records the call and calls {{.Name}}Stub.
+func (fake *FakeSchematicsV1) {{.Name}}WithContext({{params .Params true}}) ({{params .Results true}}) {
+	fake.record("{{.Name}}", {{names .Params}})
+	fake.mutex.Lock()
+	stub := fake.{{.Name}}Stub
+	fake.mutex.Unlock()
+	if stub == nil {
+		err = notStubbed("{{.Name}}")
+		return
+	}
+	return stub({{names .Params}})
+}
+
+// {{.Name}}Returns : Stubs {{.Name}} to return fixed values.
+func (fake *FakeSchematicsV1) {{.Name}}Returns({{params .Results true}}) {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+	fake.{{.Name}}Stub = func({{types .Params}}) ({{types .Results}}) {
+		return {{names .Results}}
+	}
+}
+
+// {{.Name}}CallCount : Returns the number of calls to {{.Name}} and {{.Name}}WithContext.
+func (fake *FakeSchematicsV1) {{.Name}}CallCount() int {
+	return fake.CallCount("{{.Name}}")
+}
+
+// {{.Name}}ArgsForCall : Returns the arguments of the i-th call to {{.Name}} or {{.Name}}WithContext.
+func (fake *FakeSchematicsV1) {{.Name}}ArgsForCall(i int) ({{types .Params}}) {
+	call := fake.call("{{.Name}}", i)
+	{{- range $index, $param := .Params}}
+	{{- if eq $index 0}}
+	{{$param.Name}} := call.Context
+	{{- else}}
+	{{$param.Name}}, _ := call.Options.({{$param.QualifiedType}})
+	{{- end}}
+	{{- end}}
+	return {{names .Params}}
+}
+{{end}}
+{{- range .Constructors}}
+// {{.Name}} : Calls SchematicsV1.{{.Name}}.
+func (fake *FakeSchematicsV1) {{.Name}}({{params .Params true}}) ({{types .Results}}) {
+	return (*schematicsv1.SchematicsV1)(nil).{{.Name}}({{names .Params}})
+}
+{{end -}}
+`))
@@ -0,0 +1,85 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGeneratedFilesUpToDate fails when schematics_v1.go changed without running "go generate".
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	dir := filepath.Join("..", "..")
+	files, err := generate(dir)
+	assert.Nil(t, err)
+	assert.Len(t, files, 2)
+	for name, content := range files {
+		existing, err := os.ReadFile(filepath.Join(dir, name))
+		assert.Nil(t, err)
+		assert.True(t, string(existing) == string(content), "%s is out of date, run \"go generate ./schematicsv1\"", name)
+	}
+}
+
+// TestGenerateDirectives fails when a go:generate directive of the repository runs a package that does not exist
+// relative to the directory of its file, which breaks "go generate ./..." from the repository root.
+func TestGenerateDirectives(t *testing.T) {
+	root := filepath.Join("..", "..", "..")
+	directives := 0
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() || !strings.HasSuffix(path, ".go") {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		for _, line := range strings.Split(string(data), "\n") {
+			fields := strings.Fields(strings.TrimPrefix(line, "//go:generate "))
+			if !strings.HasPrefix(line, "//go:generate ") || len(fields) < 3 || fields[0] != "go" || fields[1] != "run" {
+				continue
+			}
+			directives++
+			_, err := os.Stat(filepath.Join(filepath.Dir(path), fields[2]))
+			assert.Nil(t, err, "%s: %s", path, line)
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, directives)
+}
+
+func TestGenerateOperations(t *testing.T) {
+	files, err := generate(filepath.Join("..", ".."))
+	assert.Nil(t, err)
+	api := string(files[interfaceFile])
+	assert.Contains(t, api, "\tGetWorkspace(getWorkspaceOptions *GetWorkspaceOptions) (result *WorkspaceResponse, response *core.DetailedResponse, err error)\n")
+	assert.Contains(t, api, "\tGetWorkspaceWithContext(ctx context.Context, getWorkspaceOptions *GetWorkspaceOptions) (result *WorkspaceResponse, response *core.DetailedResponse, err error)\n")
+	assert.Contains(t, api, "\tDeleteJob(deleteJobOptions *DeleteJobOptions) (response *core.DetailedResponse, err error)\n")
+	assert.Contains(t, api, "\tNewGetWorkspaceOptions(wID string) *GetWorkspaceOptions\n")
+
+	// Hand-written methods of SchematicsV1 are not part of the interface.
+	assert.NotContains(t, api, "\tClone(")
+	assert.NotContains(t, api, "\tUse(")
+
+	fake := string(files[fakeFile])
+	assert.Contains(t, fake, "\tGetWorkspaceStub func(context.Context, *schematicsv1.GetWorkspaceOptions) (*schematicsv1.WorkspaceResponse, *core.DetailedResponse, error)\n")
+}
@@ -0,0 +1,307 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// RedactedValue replaces the values of secrets in logged requests and responses.
+const RedactedValue = "[REDACTED]"
+
+// DefaultMaxLoggedBodySize is the default maximum number of body bytes logged for a request or response.
+const DefaultMaxLoggedBodySize = 64 * 1024
+
+// DefaultRedactedFields are the header, query parameter and JSON property names whose values are always redacted.
+var DefaultRedactedFields = []string{
+	"Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"X-Github-token",
+	"refresh_token",
+	"delegated_token",
+	"git_token",
+}
+
+// LoggingOptions : The options for NewLoggingInterceptor.
+type LoggingOptions struct {
+	// The logger that receives the log records; slog.Default() if nil.
+	Logger *slog.Logger
+
+	// The level of the log records; slog.LevelDebug if nil.
+	Level slog.Leveler
+
+	// Log the request and response bodies in addition to the headers.
+	LogBodies bool
+
+	// The maximum number of body bytes logged for a request or response; DefaultMaxLoggedBodySize if 0.
+	MaxBodySize int
+
+	// The names of additional headers, query parameters and JSON properties whose values are redacted.
+	// Names are matched case-insensitively.
+	RedactFields []string
+}
+
+// NewLoggingInterceptor returns an interceptor that logs each request and its response as structured log records
+// with the attributes "operation", "attempt", "method" and "url", followed by "headers" and "body" for requests
+// and "status", "duration", "headers" and "body" for responses.
+//
+// Secrets are redacted: the values of the DefaultRedactedFields and the LoggingOptions.RedactFields, the values of
+// credential variables (CredentialVariableData), and the values of variables and environment values that are
+// marked as secure (e.g. VariableMetadata.Secure, WorkspaceVariableRequest.Secure, EnvironmentValuesMetadata.Secure).
+func NewLoggingInterceptor(options *LoggingOptions) Interceptor {
+	if options == nil {
+		options = &LoggingOptions{}
+	}
+	logger := options.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	leveler := options.Level
+	if leveler == nil {
+		leveler = slog.LevelDebug
+	}
+	maxBodySize := options.MaxBodySize
+	if maxBodySize <= 0 {
+		maxBodySize = DefaultMaxLoggedBodySize
+	}
+	redactor := newRedactor(options.RedactFields)
+
+	return func(call *Call, next Handler) (*http.Response, error) {
+		ctx := call.Request.Context()
+		level := leveler.Level()
+		if !logger.Enabled(ctx, level) {
+			return next(call)
+		}
+
+		attrs := []slog.Attr{
+			slog.String("operation", call.OperationID),
+			slog.Int("attempt", call.Attempt),
+			slog.String("method", call.Request.Method),
+			slog.String("url", redactor.redactURL(call.Request.URL.String())),
+		}
+		requestAttrs := append(attrs, redactor.headerAttr(call.Request.Header))
+		if options.LogBodies && call.Request.GetBody != nil {
+			if body, err := call.Request.GetBody(); err == nil {
+				data, _ := io.ReadAll(io.LimitReader(body, int64(maxBodySize)+1))
+				body.Close()
+				requestAttrs = append(requestAttrs, redactor.bodyAttr(call.Request.Header, data, maxBodySize))
+			}
+		}
+		logger.LogAttrs(ctx, level, "Schematics request", requestAttrs...)
+
+		start := time.Now()
+		response, err := next(call)
+		attrs = append(attrs, slog.Duration("duration", time.Since(start)))
+		if err != nil {
+			logger.LogAttrs(ctx, level, "Schematics request failed", append(attrs, slog.String("error", err.Error()))...)
+			return response, err
+		}
+
+		responseAttrs := append(attrs, slog.Int("status", response.StatusCode), redactor.headerAttr(response.Header))
+		if options.LogBodies && response.Body != nil {
+			// Only the logged prefix of the body is buffered; the remainder is streamed to the caller.
+			data, _ := io.ReadAll(io.LimitReader(response.Body, int64(maxBodySize)+1))
+			response.Body = struct {
+				io.Reader
+				io.Closer
+			}{io.MultiReader(bytes.NewReader(data), response.Body), response.Body}
+			responseAttrs = append(responseAttrs, redactor.bodyAttr(response.Header, data, maxBodySize))
+		}
+		logger.LogAttrs(ctx, level, "Schematics response", responseAttrs...)
+		return response, err
+	}
+}
+
+// redactor redacts the secrets contained in requests and responses.
+type redactor struct {
+	fields map[string]bool
+}
+
+// newRedactor returns a redactor for the DefaultRedactedFields and "fields".
+func newRedactor(fields []string) *redactor {
+	redactor := &redactor{fields: make(map[string]bool)}
+	for _, field := range append(append([]string{}, DefaultRedactedFields...), fields...) {
+		redactor.fields[strings.ToLower(field)] = true
+	}
+	return redactor
+}
+
+// isRedacted returns true if the values of "field" are redacted.
+func (redactor *redactor) isRedacted(field string) bool {
+	return redactor.fields[strings.ToLower(field)]
+}
+
+// redactHeader returns a copy of "header" with the values of the redacted fields replaced.
+func (redactor *redactor) redactHeader(header http.Header) http.Header {
+	redacted := header.Clone()
+	for name, values := range redacted {
+		if redactor.isRedacted(name) {
+			for i := range values {
+				values[i] = RedactedValue
+			}
+		}
+	}
+	return redacted
+}
+
+// headerAttr returns the redacted "header" as a group attribute with one string attribute per header name.
+func (redactor *redactor) headerAttr(header http.Header) slog.Attr {
+	redacted := redactor.redactHeader(header)
+	names := make([]string, 0, len(redacted))
+	for name := range redacted {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	attrs := make([]any, 0, len(names))
+	for _, name := range names {
+		attrs = append(attrs, slog.String(name, strings.Join(redacted[name], ", ")))
+	}
+	return slog.Group("headers", attrs...)
+}
+
+// redactURL returns "rawURL" with the values of the redacted query parameters replaced.
+func (redactor *redactor) redactURL(rawURL string) string {
+	path, rawQuery, found := strings.Cut(rawURL, "?")
+	if !found {
+		return rawURL
+	}
+	params := strings.Split(rawQuery, "&")
+	for i, param := range params {
+		if name, _, ok := strings.Cut(param, "="); ok && redactor.isRedacted(name) {
+			params[i] = name + "=" + RedactedValue
+		}
+	}
+	return path + "?" + strings.Join(params, "&")
+}
+
+// bodyAttr returns the "body" attribute for a body whose first bytes are "data". JSON bodies are redacted and
+// logged as JSON, other textual bodies are logged as strings truncated to "maxSize" bytes, and binary bodies are
+// omitted.
+func (redactor *redactor) bodyAttr(header http.Header, data []byte, maxSize int) slog.Attr {
+	mediaType, _, _ := mime.ParseMediaType(header.Get("Content-Type"))
+	switch {
+	case len(data) == 0:
+		return slog.String("body", "")
+	case len(data) > maxSize:
+		if strings.HasPrefix(mediaType, "text/") {
+			return slog.String("body", string(data[:maxSize])+"...")
+		}
+		return slog.String("body", "<truncated>")
+	case strings.HasSuffix(mediaType, "json"):
+		if redacted, err := redactor.redactJSON(data); err == nil {
+			return slog.Any("body", json.RawMessage(redacted))
+		}
+		return slog.String("body", "<invalid JSON>")
+	case strings.HasPrefix(mediaType, "text/"):
+		return slog.String("body", string(data))
+	default:
+		return slog.String("body", "<binary>")
+	}
+}
+
+// redactJSON returns the JSON document "data" with its secrets redacted.
+func (redactor *redactor) redactJSON(data []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return nil, err
+	}
+	return json.Marshal(redactor.redactValue(document))
+}
+
+// redactValue redacts the secrets contained in a decoded JSON value, in place.
+func (redactor *redactor) redactValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case []interface{}:
+		for i, element := range value {
+			value[i] = redactor.redactValue(element)
+		}
+	case map[string]interface{}:
+		// Variables marked as secure, either directly (WorkspaceVariableRequest) or in their metadata (VariableData).
+		if metadata, ok := value["metadata"].(map[string]interface{}); value["secure"] == true || ok && metadata["secure"] == true {
+			redactVariable(value)
+		}
+		// Environment values marked as secure in the environment values metadata.
+		if environmentValues, ok := value["env_values"].([]interface{}); ok {
+			redactEnvironmentValues(environmentValues, value["env_values_metadata"])
+		}
+		for name, property := range value {
+			switch {
+			case property == nil:
+			case redactor.isRedacted(name):
+				value[name] = RedactedValue
+			case name == "credentials" || name == "bastion_credential":
+				// Credential variables (CredentialVariableData) are always secret.
+				if credentials, ok := property.([]interface{}); ok {
+					for _, credential := range credentials {
+						if credential, ok := credential.(map[string]interface{}); ok {
+							redactVariable(credential)
+						}
+					}
+				} else if credential, ok := property.(map[string]interface{}); ok {
+					redactVariable(credential)
+				}
+			default:
+				value[name] = redactor.redactValue(property)
+			}
+		}
+	}
+	return value
+}
+
+// redactVariable redacts the value and the default value of a variable.
+func redactVariable(variable map[string]interface{}) {
+	if variable["value"] != nil {
+		variable["value"] = RedactedValue
+	}
+	if metadata, ok := variable["metadata"].(map[string]interface{}); ok && metadata["default_value"] != nil {
+		metadata["default_value"] = RedactedValue
+	}
+}
+
+// redactEnvironmentValues redacts the environment values that are marked as secure in "metadata".
+func redactEnvironmentValues(environmentValues []interface{}, metadata interface{}) {
+	elements, _ := metadata.([]interface{})
+	secure := make(map[string]bool)
+	for _, element := range elements {
+		if element, ok := element.(map[string]interface{}); ok && element["secure"] == true {
+			if name, ok := element["name"].(string); ok {
+				secure[name] = true
+			}
+		}
+	}
+	for _, environmentValue := range environmentValues {
+		if environmentValue, ok := environmentValue.(map[string]interface{}); ok {
+			for name := range environmentValue {
+				if secure[name] {
+					environmentValue[name] = RedactedValue
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,192 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Logging`, func() {
+	var testServer *httptest.Server
+	var schematicsService *schematicsv1.SchematicsV1
+	var responseBody string
+	var logs bytes.Buffer
+
+	// logRecords returns the log records written so far.
+	logRecords := func() []map[string]interface{} {
+		var records []map[string]interface{}
+		for _, line := range strings.Split(strings.TrimSpace(logs.String()), "\n") {
+			var record map[string]interface{}
+			Expect(json.Unmarshal([]byte(line), &record)).To(Succeed())
+			records = append(records, record)
+		}
+		return records
+	}
+
+	BeforeEach(func() {
+		logs.Reset()
+		responseBody = `{"id": "testString"}`
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprint(res, responseBody)
+		}))
+
+		var serviceErr error
+		schematicsService, serviceErr = schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Log requests and responses`, func() {
+		schematicsService.Use(schematicsv1.NewLoggingInterceptor(&schematicsv1.LoggingOptions{
+			Logger:    slog.New(slog.NewJSONHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug})),
+			LogBodies: true,
+		}))
+
+		_, _, operationErr := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("testString"))
+		Expect(operationErr).To(BeNil())
+
+		records := logRecords()
+		Expect(records).To(HaveLen(2))
+		Expect(records[0]["level"]).To(Equal("DEBUG"))
+		Expect(records[0]["msg"]).To(Equal("Schematics request"))
+		Expect(records[0]["operation"]).To(Equal("GetWorkspace"))
+		Expect(records[0]["method"]).To(Equal("GET"))
+		Expect(records[0]["url"]).To(Equal(testServer.URL + "/v1/workspaces/testString"))
+		Expect(records[0]["headers"]).To(HaveKeyWithValue("Accept", "application/json"))
+		Expect(records[1]["msg"]).To(Equal("Schematics response"))
+		Expect(records[1]["status"]).To(BeNumerically("==", 200))
+		Expect(records[1]["duration"]).ToNot(BeNil())
+		Expect(records[1]["body"]).To(Equal(map[string]interface{}{"id": "testString"}))
+	})
+	It(`Redact secrets`, func() {
+		schematicsService.Use(schematicsv1.NewLoggingInterceptor(&schematicsv1.LoggingOptions{
+			Logger:       slog.New(slog.NewJSONHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug})),
+			LogBodies:    true,
+			RedactFields: []string{"ssh_key"},
+		}))
+		responseBody = `{"id": "testString", "inputs": [{"name": "password", "value": "response-secret", "metadata": {"secure": true}}]}`
+
+		createActionOptions := schematicsService.NewCreateActionOptions()
+		createActionOptions.SetName("myaction")
+		createActionOptions.SetXGithubToken("github-token")
+		createActionOptions.SetSource(&schematicsv1.ExternalSource{
+			SourceType: core.StringPtr("git_hub"),
+			Git: &schematicsv1.GitSource{
+				GitRepoURL: core.StringPtr("https://github.com/IBM/repo"),
+				GitToken:   core.StringPtr("git-token"),
+			},
+		})
+		createActionOptions.SetCredentials([]schematicsv1.CredentialVariableData{{
+			Name:     core.StringPtr("ssh_private_key"),
+			Value:    core.StringPtr("credential-value"),
+			Metadata: &schematicsv1.CredentialVariableMetadata{DefaultValue: core.StringPtr("credential-default")},
+		}})
+		createActionOptions.SetInputs([]schematicsv1.VariableData{
+			{Name: core.StringPtr("region"), Value: core.StringPtr("us-south")},
+			{Name: core.StringPtr("api_key"), Value: core.StringPtr("input-secret"), Metadata: &schematicsv1.VariableMetadata{Secure: core.BoolPtr(true)}},
+		})
+		createActionOptions.SetSettings([]schematicsv1.VariableData{
+			{Name: core.StringPtr("ssh_key"), Value: core.StringPtr("custom-secret")},
+		})
+		_, _, operationErr := schematicsService.CreateAction(createActionOptions)
+		Expect(operationErr).To(BeNil())
+
+		_, _, operationErr = schematicsService.ApplyWorkspaceCommand(schematicsService.NewApplyWorkspaceCommandOptions("testString", "refresh-token"))
+		Expect(operationErr).To(BeNil())
+
+		output := logs.String()
+		for _, secret := range []string{"github-token", "git-token", "credential-value", "credential-default", "input-secret", "response-secret", "refresh-token"} {
+			Expect(output).ToNot(ContainSubstring(secret))
+		}
+		Expect(output).To(ContainSubstring("us-south"))
+		Expect(output).To(ContainSubstring("custom-secret"))
+
+		records := logRecords()
+		Expect(records).To(HaveLen(4))
+		Expect(records[0]["headers"]).To(HaveKeyWithValue("X-Github-token", schematicsv1.RedactedValue))
+		Expect(records[2]["headers"]).To(HaveKeyWithValue("refresh_token", schematicsv1.RedactedValue))
+	})
+	It(`Redact secure environment values and custom fields`, func() {
+		schematicsService.Use(schematicsv1.NewLoggingInterceptor(&schematicsv1.LoggingOptions{
+			Logger:       slog.New(slog.NewJSONHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug})),
+			LogBodies:    true,
+			RedactFields: []string{"Description"},
+		}))
+
+		createWorkspaceOptions := schematicsService.NewCreateWorkspaceOptions()
+		createWorkspaceOptions.SetDescription("custom-secret")
+		createWorkspaceOptions.SetTemplateData([]schematicsv1.TemplateSourceDataRequest{{
+			EnvValues: []map[string]interface{}{{"TF_LOG": "DEBUG"}, {"TF_VAR_token": "env-secret"}},
+			EnvValuesMetadata: []schematicsv1.EnvironmentValuesMetadata{
+				{Name: core.StringPtr("TF_VAR_token"), Secure: core.BoolPtr(true)},
+			},
+			Variablestore: []schematicsv1.WorkspaceVariableRequest{
+				{Name: core.StringPtr("password"), Value: core.StringPtr("variable-secret"), Secure: core.BoolPtr(true)},
+			},
+		}})
+		_, _, operationErr := schematicsService.CreateWorkspace(createWorkspaceOptions)
+		Expect(operationErr).To(BeNil())
+
+		output := logs.String()
+		for _, secret := range []string{"custom-secret", "env-secret", "variable-secret"} {
+			Expect(output).ToNot(ContainSubstring(secret))
+		}
+		Expect(output).To(ContainSubstring("TF_LOG"))
+	})
+	It(`Leave the response body intact`, func() {
+		responseBody = `{"id": "` + strings.Repeat("x", 100) + `"}`
+		schematicsService.Use(schematicsv1.NewLoggingInterceptor(&schematicsv1.LoggingOptions{
+			Logger:      slog.New(slog.NewJSONHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug})),
+			LogBodies:   true,
+			MaxBodySize: 10,
+		}))
+
+		workspace, _, operationErr := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("testString"))
+		Expect(operationErr).To(BeNil())
+		Expect(*workspace.ID).To(HaveLen(100))
+		Expect(logRecords()[1]["body"]).To(Equal("<truncated>"))
+	})
+	It(`Skip logging when the level is disabled`, func() {
+		schematicsService.Use(schematicsv1.NewLoggingInterceptor(&schematicsv1.LoggingOptions{
+			Logger: slog.New(slog.NewJSONHandler(&logs, &slog.HandlerOptions{Level: slog.LevelInfo})),
+		}))
+
+		_, _, operationErr := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("testString"))
+		Expect(operationErr).To(BeNil())
+		Expect(logs.Len()).To(BeZero())
+	})
+})
@@ -0,0 +1,162 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Error classes reported in RequestMetrics.ErrorClass.
+const (
+	ErrorClassRateLimited = "rate_limited"
+	ErrorClassNotFound    = "not_found"
+	ErrorClassConflict    = "conflict"
+	ErrorClassClient      = "client_error"
+	ErrorClassServer      = "server_error"
+	ErrorClassCanceled    = "canceled"
+	ErrorClassTimeout     = "timeout"
+	ErrorClassNetwork     = "network_error"
+)
+
+// RequestMetrics : The measurements taken for a single HTTP request sent by a SchematicsV1 instance.
+type RequestMetrics struct {
+	// The operationId of the operation that sent the request, e.g. "GetWorkspace".
+	OperationID string
+
+	// The HTTP method of the request.
+	Method string
+
+	// The HTTP status code of the response, or 0 if no response was received.
+	StatusCode int
+
+	// The class of the error, or an empty string if the request succeeded. One of the ErrorClass* constants.
+	ErrorClass string
+
+	// The attempt number of the request; greater than 1 if the request was resent by automatic retries.
+	Attempt int
+
+	// The time from sending the request until the response headers were received.
+	Duration time.Duration
+
+	// The size of the request body in bytes, or -1 if unknown.
+	RequestSize int64
+
+	// The number of bytes read from the response body.
+	ResponseSize int64
+}
+
+// MetricsSink receives the measurements taken for each request sent by a SchematicsV1 instance.
+// Implementations must be safe for concurrent use.
+type MetricsSink interface {
+	ObserveRequest(metrics RequestMetrics)
+}
+
+// NewMetricsInterceptor returns an interceptor that reports the measurements of each request to "sink".
+// Requests that receive a response are reported once the response body has been read or closed.
+func NewMetricsInterceptor(sink MetricsSink) Interceptor {
+	return func(call *Call, next Handler) (*http.Response, error) {
+		metrics := RequestMetrics{
+			OperationID: call.OperationID,
+			Method:      call.Request.Method,
+			Attempt:     call.Attempt,
+			RequestSize: call.Request.ContentLength,
+		}
+		if call.Request.Body == nil || call.Request.Body == http.NoBody {
+			metrics.RequestSize = 0
+		}
+
+		start := time.Now()
+		response, err := next(call)
+		metrics.Duration = time.Since(start)
+		metrics.ErrorClass = classifyError(response, err)
+
+		if err != nil || response == nil {
+			sink.ObserveRequest(metrics)
+			return response, err
+		}
+
+		metrics.StatusCode = response.StatusCode
+		response.Body = &countingBody{
+			ReadCloser: response.Body,
+			done: func(size int64) {
+				metrics.ResponseSize = size
+				sink.ObserveRequest(metrics)
+			},
+		}
+		return response, err
+	}
+}
+
+// classifyError returns the error class of a request that completed with "response" and "err".
+func classifyError(response *http.Response, err error) string {
+	if err != nil {
+		var netError net.Error
+		switch {
+		case errors.Is(err, context.Canceled):
+			return ErrorClassCanceled
+		case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netError) && netError.Timeout():
+			return ErrorClassTimeout
+		default:
+			return ErrorClassNetwork
+		}
+	}
+
+	switch statusCode := response.StatusCode; {
+	case statusCode < 400:
+		return ""
+	case statusCode == http.StatusTooManyRequests:
+		return ErrorClassRateLimited
+	case statusCode == http.StatusNotFound:
+		return ErrorClassNotFound
+	case statusCode == http.StatusConflict:
+		return ErrorClassConflict
+	case statusCode < 500:
+		return ErrorClassClient
+	default:
+		return ErrorClassServer
+	}
+}
+
+// countingBody counts the bytes read from a response body and invokes "done" once, when the body has been
+// read to the end or closed.
+type countingBody struct {
+	io.ReadCloser
+	size int64
+	once sync.Once
+	done func(size int64)
+}
+
+func (body *countingBody) Read(p []byte) (n int, err error) {
+	n, err = body.ReadCloser.Read(p)
+	body.size += int64(n)
+	if err == io.EOF {
+		body.once.Do(func() { body.done(body.size) })
+	}
+	return
+}
+
+func (body *countingBody) Close() error {
+	err := body.ReadCloser.Close()
+	body.once.Do(func() { body.done(body.size) })
+	return err
+}
@@ -0,0 +1,219 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultPrometheusNamespace is the prefix of the metric names exposed by PrometheusMetrics.
+const DefaultPrometheusNamespace = "schematics_sdk"
+
+// DefaultDurationBuckets are the upper bounds, in seconds, of the request duration histogram buckets.
+var DefaultDurationBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+
+// DefaultSizeBuckets are the upper bounds, in bytes, of the payload size histogram buckets.
+var DefaultSizeBuckets = []float64{256, 1024, 4096, 16384, 65536, 262144, 1048576, 4194304}
+
+// PrometheusMetrics : A MetricsSink that aggregates the request measurements in memory and exposes them in the
+// Prometheus text exposition format, either through WriteTo or as an http.Handler. The following metrics are
+// exposed, each prefixed with the namespace:
+//
+//   - requests_total{operation, status_code}: number of requests sent
+//   - request_errors_total{operation, error_class}: number of failed requests
+//   - request_retries_total{operation}: number of requests resent by automatic retries
+//   - request_duration_seconds{operation}: histogram of the time until the response headers were received
+//   - request_size_bytes{operation}: histogram of the request body sizes
+//   - response_size_bytes{operation}: histogram of the response body sizes
+type PrometheusMetrics struct {
+	namespace string
+
+	mutex         sync.Mutex
+	requests      map[[2]string]float64
+	errors        map[[2]string]float64
+	retries       map[string]float64
+	durations     map[string]*histogram
+	requestSizes  map[string]*histogram
+	responseSizes map[string]*histogram
+}
+
+// histogram holds the cumulative bucket counts, sum and count of a Prometheus histogram.
+type histogram struct {
+	upperBounds []float64
+	counts      []float64
+	sum         float64
+	count       float64
+}
+
+func (h *histogram) observe(value float64) {
+	for i, upperBound := range h.upperBounds {
+		if value <= upperBound {
+			h.counts[i]++
+		}
+	}
+	h.sum += value
+	h.count++
+}
+
+// NewPrometheusMetrics constructs a PrometheusMetrics instance that prefixes metric names with "namespace".
+// If "namespace" is empty, DefaultPrometheusNamespace is used.
+func NewPrometheusMetrics(namespace string) *PrometheusMetrics {
+	if namespace == "" {
+		namespace = DefaultPrometheusNamespace
+	}
+	return &PrometheusMetrics{
+		namespace:     namespace,
+		requests:      make(map[[2]string]float64),
+		errors:        make(map[[2]string]float64),
+		retries:       make(map[string]float64),
+		durations:     make(map[string]*histogram),
+		requestSizes:  make(map[string]*histogram),
+		responseSizes: make(map[string]*histogram),
+	}
+}
+
+// ObserveRequest records the measurements of a single request.
+func (prometheus *PrometheusMetrics) ObserveRequest(metrics RequestMetrics) {
+	prometheus.mutex.Lock()
+	defer prometheus.mutex.Unlock()
+
+	operation := metrics.OperationID
+	statusCode := ""
+	if metrics.StatusCode != 0 {
+		statusCode = strconv.Itoa(metrics.StatusCode)
+	}
+	prometheus.requests[[2]string{operation, statusCode}]++
+	if metrics.ErrorClass != "" {
+		prometheus.errors[[2]string{operation, metrics.ErrorClass}]++
+	}
+	if metrics.Attempt > 1 {
+		prometheus.retries[operation]++
+	}
+
+	observe(prometheus.durations, operation, DefaultDurationBuckets, metrics.Duration.Seconds())
+	if metrics.RequestSize >= 0 {
+		observe(prometheus.requestSizes, operation, DefaultSizeBuckets, float64(metrics.RequestSize))
+	}
+	if metrics.StatusCode != 0 {
+		observe(prometheus.responseSizes, operation, DefaultSizeBuckets, float64(metrics.ResponseSize))
+	}
+}
+
+// observe records "value" in the histogram for "operation", creating the histogram if needed.
+func observe(histograms map[string]*histogram, operation string, upperBounds []float64, value float64) {
+	h, ok := histograms[operation]
+	if !ok {
+		h = &histogram{upperBounds: upperBounds, counts: make([]float64, len(upperBounds))}
+		histograms[operation] = h
+	}
+	h.observe(value)
+}
+
+// WriteTo writes the metrics to "w" in the Prometheus text exposition format.
+func (prometheus *PrometheusMetrics) WriteTo(w io.Writer) (int64, error) {
+	var buf bytes.Buffer
+
+	prometheus.mutex.Lock()
+	prometheus.writeCounters(&buf, "requests_total", "Number of requests sent to the Schematics service.",
+		"status_code", prometheus.requests)
+	prometheus.writeCounters(&buf, "request_errors_total", "Number of requests to the Schematics service that failed.",
+		"error_class", prometheus.errors)
+	retries := make(map[[2]string]float64, len(prometheus.retries))
+	for operation, value := range prometheus.retries {
+		retries[[2]string{operation}] = value
+	}
+	prometheus.writeCounters(&buf, "request_retries_total", "Number of requests to the Schematics service that were resent by automatic retries.",
+		"", retries)
+	prometheus.writeHistograms(&buf, "request_duration_seconds", "Time until the response headers were received from the Schematics service.",
+		prometheus.durations)
+	prometheus.writeHistograms(&buf, "request_size_bytes", "Size of the request bodies sent to the Schematics service.",
+		prometheus.requestSizes)
+	prometheus.writeHistograms(&buf, "response_size_bytes", "Size of the response bodies received from the Schematics service.",
+		prometheus.responseSizes)
+	prometheus.mutex.Unlock()
+
+	return buf.WriteTo(w)
+}
+
+// ServeHTTP writes the metrics to the response in the Prometheus text exposition format.
+func (prometheus *PrometheusMetrics) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	res.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_, _ = prometheus.WriteTo(res)
+}
+
+// writeCounters writes a counter family whose series are labeled by operation and, if "label" is not empty, by
+// "label" with the second element of each key as value.
+func (prometheus *PrometheusMetrics) writeCounters(buf *bytes.Buffer, name string, help string, label string, values map[[2]string]float64) {
+	fullName := prometheus.namespace + "_" + name
+	fmt.Fprintf(buf, "# HELP %s %s\n# TYPE %s counter\n", fullName, help, fullName)
+
+	keys := make([][2]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i][0] < keys[j][0] || (keys[i][0] == keys[j][0] && keys[i][1] < keys[j][1])
+	})
+
+	for _, key := range keys {
+		labels := `operation="` + escapeLabelValue(key[0]) + `"`
+		if label != "" {
+			labels += `,` + label + `="` + escapeLabelValue(key[1]) + `"`
+		}
+		fmt.Fprintf(buf, "%s{%s} %s\n", fullName, labels, formatValue(values[key]))
+	}
+}
+
+// writeHistograms writes a histogram family whose series are labeled by operation.
+func (prometheus *PrometheusMetrics) writeHistograms(buf *bytes.Buffer, name string, help string, histograms map[string]*histogram) {
+	fullName := prometheus.namespace + "_" + name
+	fmt.Fprintf(buf, "# HELP %s %s\n# TYPE %s histogram\n", fullName, help, fullName)
+
+	operations := make([]string, 0, len(histograms))
+	for operation := range histograms {
+		operations = append(operations, operation)
+	}
+	sort.Strings(operations)
+
+	for _, operation := range operations {
+		h := histograms[operation]
+		labels := `operation="` + escapeLabelValue(operation) + `"`
+		for i, upperBound := range h.upperBounds {
+			fmt.Fprintf(buf, "%s_bucket{%s,le=\"%s\"} %s\n", fullName, labels, formatValue(upperBound), formatValue(h.counts[i]))
+		}
+		fmt.Fprintf(buf, "%s_bucket{%s,le=\"+Inf\"} %s\n", fullName, labels, formatValue(h.count))
+		fmt.Fprintf(buf, "%s_sum{%s} %s\n", fullName, labels, formatValue(h.sum))
+		fmt.Fprintf(buf, "%s_count{%s} %s\n", fullName, labels, formatValue(h.count))
+	}
+}
+
+// escapeLabelValue escapes a label value as required by the Prometheus text exposition format.
+func escapeLabelValue(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+}
+
+// formatValue formats a sample value as required by the Prometheus text exposition format.
+func formatValue(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
@@ -0,0 +1,185 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// recordingSink is a MetricsSink that records every observation.
+type recordingSink struct {
+	mutex        sync.Mutex
+	observations []schematicsv1.RequestMetrics
+}
+
+func (sink *recordingSink) ObserveRequest(metrics schematicsv1.RequestMetrics) {
+	sink.mutex.Lock()
+	defer sink.mutex.Unlock()
+	sink.observations = append(sink.observations, metrics)
+}
+
+var _ = Describe(`Metrics`, func() {
+	var testServer *httptest.Server
+	var schematicsService *schematicsv1.SchematicsV1
+	var statusCodes []int
+
+	BeforeEach(func() {
+		statusCodes = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			statusCode := 200
+			if len(statusCodes) > 0 {
+				statusCode = statusCodes[0]
+				statusCodes = statusCodes[1:]
+			}
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(statusCode)
+			fmt.Fprint(res, `{"id": "testString"}`)
+		}))
+
+		var serviceErr error
+		schematicsService, serviceErr = schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	Describe(`NewMetricsInterceptor`, func() {
+		var sink *recordingSink
+
+		BeforeEach(func() {
+			sink = &recordingSink{}
+			schematicsService.Use(schematicsv1.NewMetricsInterceptor(sink))
+		})
+
+		It(`Record successful requests`, func() {
+			createWorkspaceOptions := schematicsService.NewCreateWorkspaceOptions()
+			createWorkspaceOptions.SetName("myworkspace")
+			_, _, operationErr := schematicsService.CreateWorkspace(createWorkspaceOptions)
+			Expect(operationErr).To(BeNil())
+
+			Expect(sink.observations).To(HaveLen(1))
+			metrics := sink.observations[0]
+			Expect(metrics.OperationID).To(Equal("CreateWorkspace"))
+			Expect(metrics.Method).To(Equal("POST"))
+			Expect(metrics.StatusCode).To(Equal(200))
+			Expect(metrics.ErrorClass).To(BeEmpty())
+			Expect(metrics.Attempt).To(Equal(1))
+			Expect(metrics.Duration).To(BeNumerically(">", 0))
+			Expect(metrics.RequestSize).To(BeNumerically(">", 0))
+			Expect(metrics.ResponseSize).To(Equal(int64(len(`{"id": "testString"}`))))
+		})
+		It(`Record error classes and retries`, func() {
+			schematicsService.EnableRetries(3, 10*time.Millisecond)
+			statusCodes = []int{429, 503, 404}
+
+			_, _, operationErr := schematicsService.GetJob(schematicsService.NewGetJobOptions("testString"))
+			Expect(operationErr).ToNot(BeNil())
+
+			Expect(sink.observations).To(HaveLen(3))
+			var errorClasses []string
+			for i, metrics := range sink.observations {
+				Expect(metrics.OperationID).To(Equal("GetJob"))
+				Expect(metrics.Attempt).To(Equal(i + 1))
+				Expect(metrics.RequestSize).To(BeZero())
+				errorClasses = append(errorClasses, metrics.ErrorClass)
+			}
+			Expect(errorClasses).To(Equal([]string{
+				schematicsv1.ErrorClassRateLimited,
+				schematicsv1.ErrorClassServer,
+				schematicsv1.ErrorClassNotFound,
+			}))
+		})
+		It(`Record network errors`, func() {
+			testServer.Close()
+
+			_, _, operationErr := schematicsService.GetAction(schematicsService.NewGetActionOptions("testString"))
+			Expect(operationErr).ToNot(BeNil())
+
+			Expect(sink.observations).To(HaveLen(1))
+			Expect(sink.observations[0].StatusCode).To(BeZero())
+			Expect(sink.observations[0].ErrorClass).To(Equal(schematicsv1.ErrorClassNetwork))
+		})
+	})
+
+	Describe(`PrometheusMetrics`, func() {
+		It(`Expose metrics in the text exposition format`, func() {
+			metrics := schematicsv1.NewPrometheusMetrics("")
+			schematicsService.Use(schematicsv1.NewMetricsInterceptor(metrics))
+			schematicsService.EnableRetries(1, 10*time.Millisecond)
+			statusCodes = []int{503}
+
+			_, _, operationErr := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("testString"))
+			Expect(operationErr).To(BeNil())
+			_, _, operationErr = schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("testString"))
+			Expect(operationErr).To(BeNil())
+
+			metricsServer := httptest.NewServer(metrics)
+			defer metricsServer.Close()
+			response, err := http.Get(metricsServer.URL)
+			Expect(err).To(BeNil())
+			defer response.Body.Close()
+			Expect(response.Header.Get("Content-Type")).To(ContainSubstring("version=0.0.4"))
+			body, err := io.ReadAll(response.Body)
+			Expect(err).To(BeNil())
+
+			exposition := string(body)
+			Expect(exposition).To(ContainSubstring("# TYPE schematics_sdk_requests_total counter\n"))
+			Expect(exposition).To(ContainSubstring(`schematics_sdk_requests_total{operation="GetWorkspace",status_code="200"} 2` + "\n"))
+			Expect(exposition).To(ContainSubstring(`schematics_sdk_requests_total{operation="GetWorkspace",status_code="503"} 1` + "\n"))
+			Expect(exposition).To(ContainSubstring(`schematics_sdk_request_errors_total{operation="GetWorkspace",error_class="server_error"} 1` + "\n"))
+			Expect(exposition).To(ContainSubstring(`schematics_sdk_request_retries_total{operation="GetWorkspace"} 1` + "\n"))
+			Expect(exposition).To(ContainSubstring("# TYPE schematics_sdk_request_duration_seconds histogram\n"))
+			Expect(exposition).To(ContainSubstring(`schematics_sdk_request_duration_seconds_bucket{operation="GetWorkspace",le="+Inf"} 3` + "\n"))
+			Expect(exposition).To(ContainSubstring(`schematics_sdk_request_duration_seconds_count{operation="GetWorkspace"} 3` + "\n"))
+			Expect(exposition).To(ContainSubstring(`schematics_sdk_response_size_bytes_bucket{operation="GetWorkspace",le="256"} 3` + "\n"))
+			Expect(exposition).To(ContainSubstring(`schematics_sdk_response_size_bytes_sum{operation="GetWorkspace"} 60` + "\n"))
+		})
+		It(`Produce deterministic output with escaped label values`, func() {
+			metrics := schematicsv1.NewPrometheusMetrics("custom")
+			metrics.ObserveRequest(schematicsv1.RequestMetrics{OperationID: "b", StatusCode: 200, Attempt: 1})
+			metrics.ObserveRequest(schematicsv1.RequestMetrics{OperationID: `a"quoted\`, StatusCode: 500, ErrorClass: schematicsv1.ErrorClassServer, Attempt: 1})
+
+			var first, second strings.Builder
+			_, err := metrics.WriteTo(&first)
+			Expect(err).To(BeNil())
+			_, err = metrics.WriteTo(&second)
+			Expect(err).To(BeNil())
+			Expect(first.String()).To(Equal(second.String()))
+
+			exposition := first.String()
+			Expect(exposition).To(ContainSubstring(`custom_requests_total{operation="a\"quoted\\",status_code="500"} 1`))
+			Expect(strings.Index(exposition, `operation="a\"quoted\\"`)).To(BeNumerically("<", strings.Index(exposition, `operation="b"`)))
+		})
+	})
+})
@@ -0,0 +1,174 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package otelschematics : OpenTelemetry tracing for the operations of the SchematicsV1 service
+//
+// The package is a module of its own, so that the OpenTelemetry dependencies are only required by the
+// applications that use it:
+//
+//	go get github.com/IBM/schematics-go-sdk/schematicsv1/otelschematics
+package otelschematics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ScopeName is the instrumentation scope name of the tracer used to create spans.
+const ScopeName = "github.com/IBM/schematics-go-sdk/schematicsv1/otelschematics"
+
+// Attribute keys recorded on each span in addition to the HTTP semantic conventions.
+const (
+	// OperationKey holds the operationId of the operation, e.g. "GetWorkspace".
+	OperationKey = attribute.Key("schematics.operation")
+
+	// ResourceIDKeyPrefix is followed by the resource type to form the key that holds the ID of each resource
+	// addressed by the request, e.g. "schematics.workspace.id" or "schematics.job.id".
+	ResourceIDKeyPrefix = "schematics."
+)
+
+// config holds the settings of the tracing interceptor.
+type config struct {
+	tracerProvider trace.TracerProvider
+	propagator     propagation.TextMapPropagator
+}
+
+// Option configures the tracing interceptor.
+type Option func(*config)
+
+// WithTracerProvider sets the TracerProvider used to create spans. The global TracerProvider is used by default.
+func WithTracerProvider(tracerProvider trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = tracerProvider
+	}
+}
+
+// WithPropagator sets the propagator used to inject the trace context into the request headers. By default, the
+// W3C trace context and baggage headers are propagated.
+func WithPropagator(propagator propagation.TextMapPropagator) Option {
+	return func(c *config) {
+		c.propagator = propagator
+	}
+}
+
+// Instrument adds the tracing interceptor to the interceptor chain of "schematics".
+func Instrument(schematics *schematicsv1.SchematicsV1, options ...Option) {
+	schematics.Use(NewInterceptor(options...))
+}
+
+// operationContextKey is the key of the context of the operation span in the state of a schematicsv1.Operation.
+type operationContextKey struct{}
+
+// NewInterceptor returns an interceptor that traces the operations of a SchematicsV1 instance. Each operation gets
+// a span named after its operationId, which is a child of the span in the Context passed to the operation's
+// WithContext method, and which records the IDs of the resources addressed by the operation. Each attempt to send
+// the request of the operation gets a client span, a child of the operation span, whose trace context is injected
+// into the request headers. When automatic retries are enabled, the client spans of the retries record the number
+// of previous attempts in the "http.request.resend_count" attribute.
+func NewInterceptor(options ...Option) schematicsv1.Interceptor {
+	c := &config{}
+	for _, option := range options {
+		option(c)
+	}
+	if c.tracerProvider == nil {
+		c.tracerProvider = otel.GetTracerProvider()
+	}
+	if c.propagator == nil {
+		c.propagator = propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+	}
+	tracer := c.tracerProvider.Tracer(ScopeName)
+
+	return func(call *schematicsv1.Call, next schematicsv1.Handler) (*http.Response, error) {
+		attributes := []attribute.KeyValue{
+			OperationKey.String(call.OperationID),
+			semconv.HTTPRequestMethodKey.String(call.Request.Method),
+			semconv.ServerAddress(call.Request.URL.Hostname()),
+			semconv.URLFull(call.Request.URL.Redacted()),
+		}
+		if call.Attempt > 1 {
+			attributes = append(attributes, semconv.HTTPRequestResendCount(call.Attempt-1))
+		}
+
+		var ctx context.Context
+		if call.Operation != nil {
+			ctx = startOperation(tracer, call)
+		} else {
+			// Without an operation, the request is traced by its client span alone.
+			ctx = call.Request.Context()
+			attributes = append(attributes, resourceAttributes(call)...)
+		}
+		ctx, span := tracer.Start(ctx, call.Request.Method,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(attributes...))
+		defer span.End()
+
+		call.Request = call.Request.WithContext(ctx)
+		c.propagator.Inject(ctx, propagation.HeaderCarrier(call.Request.Header))
+
+		response, err := next(call)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return response, err
+		}
+
+		span.SetAttributes(semconv.HTTPResponseStatusCode(response.StatusCode))
+		if response.StatusCode >= 400 {
+			span.SetStatus(codes.Error, fmt.Sprintf("HTTP %d", response.StatusCode))
+		}
+		return response, err
+	}
+}
+
+// startOperation starts the span of the operation of "call" on its first attempt, and returns the context of the
+// span.
+func startOperation(tracer trace.Tracer, call *schematicsv1.Call) context.Context {
+	if ctx, ok := call.Operation.Value(operationContextKey{}).(context.Context); ok {
+		return ctx
+	}
+
+	attributes := append([]attribute.KeyValue{OperationKey.String(call.OperationID)}, resourceAttributes(call)...)
+	ctx, span := tracer.Start(call.Request.Context(), call.OperationID,
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(attributes...))
+	call.Operation.SetValue(operationContextKey{}, ctx)
+	call.Operation.OnEnd(func(err error) {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	})
+	return ctx
+}
+
+// resourceAttributes returns the attributes that hold the IDs of the resources addressed by the request of "call".
+func resourceAttributes(call *schematicsv1.Call) []attribute.KeyValue {
+	var attributes []attribute.KeyValue
+	for resourceType, resourceID := range call.ResourceIDs() {
+		attributes = append(attributes, attribute.String(ResourceIDKeyPrefix+resourceType+".id", resourceID))
+	}
+	return attributes
+}
@@ -0,0 +1,195 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package otelschematics_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	"github.com/IBM/schematics-go-sdk/schematicsv1/otelschematics"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// spanAttributes returns the attributes of "span" as a map.
+func spanAttributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attributes := make(map[attribute.Key]attribute.Value)
+	for _, kv := range span.Attributes() {
+		attributes[kv.Key] = kv.Value
+	}
+	return attributes
+}
+
+var _ = Describe(`Tracing interceptor`, func() {
+	var testServer *httptest.Server
+	var schematicsService *schematicsv1.SchematicsV1
+	var recorder *tracetest.SpanRecorder
+	var tracerProvider *sdktrace.TracerProvider
+	var failures int
+	var traceparents []string
+
+	BeforeEach(func() {
+		failures = 0
+		traceparents = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			traceparents = append(traceparents, req.Header.Get("traceparent"))
+			res.Header().Set("Content-type", "application/json")
+			if failures > 0 {
+				failures--
+				res.WriteHeader(503)
+				fmt.Fprint(res, `{"message": "unavailable"}`)
+				return
+			}
+			res.WriteHeader(200)
+			fmt.Fprint(res, `{"id": "testString"}`)
+		}))
+
+		var serviceErr error
+		schematicsService, serviceErr = schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		recorder = tracetest.NewSpanRecorder()
+		tracerProvider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+		otelschematics.Instrument(schematicsService,
+			otelschematics.WithTracerProvider(tracerProvider),
+			otelschematics.WithPropagator(propagation.TraceContext{}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Create a span for an operation`, func() {
+		ctx, parent := tracerProvider.Tracer("test").Start(context.Background(), "parent")
+		_, _, operationErr := schematicsService.GetWorkspaceWithContext(ctx, schematicsService.NewGetWorkspaceOptions("us-south.workspace.foo.abc123"))
+		parent.End()
+		Expect(operationErr).To(BeNil())
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(3))
+		clientSpan, operationSpan := spans[0], spans[1]
+		Expect(operationSpan.Name()).To(Equal("GetWorkspace"))
+		Expect(operationSpan.SpanKind()).To(Equal(trace.SpanKindInternal))
+		Expect(operationSpan.Parent().SpanID()).To(Equal(parent.SpanContext().SpanID()))
+		Expect(operationSpan.Status().Code).To(Equal(codes.Unset))
+		attributes := spanAttributes(operationSpan)
+		Expect(attributes["schematics.operation"].AsString()).To(Equal("GetWorkspace"))
+		Expect(attributes["schematics.workspace.id"].AsString()).To(Equal("us-south.workspace.foo.abc123"))
+
+		Expect(clientSpan.Name()).To(Equal("GET"))
+		Expect(clientSpan.SpanKind()).To(Equal(trace.SpanKindClient))
+		Expect(clientSpan.Parent().SpanID()).To(Equal(operationSpan.SpanContext().SpanID()))
+		attributes = spanAttributes(clientSpan)
+		Expect(attributes["http.request.method"].AsString()).To(Equal("GET"))
+		Expect(attributes["http.response.status_code"].AsInt64()).To(Equal(int64(200)))
+		Expect(attributes).ToNot(HaveKey(attribute.Key("http.request.resend_count")))
+
+		Expect(traceparents).To(HaveLen(1))
+		Expect(traceparents[0]).To(ContainSubstring(clientSpan.SpanContext().SpanID().String()))
+	})
+	It(`Create one operation span and a client span for each retry`, func() {
+		schematicsService.EnableRetries(2, 10*time.Millisecond)
+		failures = 1
+
+		_, _, operationErr := schematicsService.GetJob(schematicsService.NewGetJobOptions("job-1"))
+		Expect(operationErr).To(BeNil())
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(3))
+		operationSpan := spans[2]
+		Expect(operationSpan.Name()).To(Equal("GetJob"))
+		Expect(operationSpan.Status().Code).To(Equal(codes.Unset))
+		Expect(spanAttributes(operationSpan)["schematics.job.id"].AsString()).To(Equal("job-1"))
+		Expect(spans[0].Parent().SpanID()).To(Equal(operationSpan.SpanContext().SpanID()))
+		Expect(spans[0].Status().Code).To(Equal(codes.Error))
+		Expect(spanAttributes(spans[0])["http.response.status_code"].AsInt64()).To(Equal(int64(503)))
+		Expect(spans[1].Parent().SpanID()).To(Equal(operationSpan.SpanContext().SpanID()))
+		Expect(spanAttributes(spans[1])["http.request.resend_count"].AsInt64()).To(Equal(int64(1)))
+		Expect(traceparents[0]).ToNot(Equal(traceparents[1]))
+	})
+	It(`Record the resource IDs of the request body`, func() {
+		createJobOptions := schematicsService.NewCreateJobOptions("refresh-token")
+		createJobOptions.SetCommandObject("workspace").SetCommandObjectID("us-south.workspace.foo.abc123")
+		_, _, operationErr := schematicsService.CreateJob(createJobOptions)
+		Expect(operationErr).To(BeNil())
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(2))
+		Expect(spans[1].Name()).To(Equal("CreateJob"))
+		Expect(spanAttributes(spans[1])["schematics.workspace.id"].AsString()).To(Equal("us-south.workspace.foo.abc123"))
+	})
+	It(`Record transport errors`, func() {
+		testServer.Close()
+
+		_, _, operationErr := schematicsService.GetAction(schematicsService.NewGetActionOptions("action-1"))
+		Expect(operationErr).ToNot(BeNil())
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(2))
+		Expect(spans[0].Name()).To(Equal("GET"))
+		Expect(spans[0].Status().Code).To(Equal(codes.Error))
+		Expect(spans[0].Events()).To(HaveLen(1))
+		Expect(spans[1].Name()).To(Equal("GetAction"))
+		Expect(spans[1].Status().Code).To(Equal(codes.Error))
+	})
+	It(`Propagate the trace context and baggage by default`, func() {
+		var baggageHeaders []string
+		baggageServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			traceparents = append(traceparents, req.Header.Get("traceparent"))
+			baggageHeaders = append(baggageHeaders, req.Header.Get("baggage"))
+			res.Header().Set("Content-type", "application/json")
+			fmt.Fprint(res, `{"id": "testString"}`)
+		}))
+		defer baggageServer.Close()
+		defaultService, serviceErr := schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			URL:           baggageServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		otelschematics.Instrument(defaultService, otelschematics.WithTracerProvider(tracerProvider))
+
+		member, err := baggage.NewMember("tenant", "acme")
+		Expect(err).To(BeNil())
+		bag, err := baggage.New(member)
+		Expect(err).To(BeNil())
+		ctx := baggage.ContextWithBaggage(context.Background(), bag)
+		_, _, operationErr := defaultService.GetWorkspaceWithContext(ctx, defaultService.NewGetWorkspaceOptions("testString"))
+		Expect(operationErr).To(BeNil())
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(2))
+		Expect(traceparents).To(HaveLen(1))
+		Expect(traceparents[0]).To(ContainSubstring(spans[0].SpanContext().TraceID().String()))
+		Expect(baggageHeaders).To(Equal([]string{"tenant=acme"}))
+	})
+})
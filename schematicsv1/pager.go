@@ -0,0 +1,420 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultPageSize is the number of items requested per page when neither the operation's Limit option
+// nor Pager.SetPageSize specify a page size.
+const DefaultPageSize int64 = 100
+
+// MaxPageSize is the largest number of items that the List* operations return per page, as documented for
+// their Limit options.
+const MaxPageSize int64 = 2000
+
+// Pager can be used to simplify the use of the List* operations that support offset-based paging.
+// It walks the pages of the operation, starting at the offset specified in the operation's options.
+type Pager[T any] struct {
+	list     func(ctx context.Context, offset int64, limit int64) (items []T, totalCount *int64, err error)
+	hasNext  bool
+	offset   int64
+	pageSize int64
+	maxItems int64
+	count    int64
+
+	// The total number of items reported by the last page, if any.
+	totalCount *int64
+}
+
+// newPager returns a pager that invokes "list" for each page, starting at "offset" with pages of "limit" items.
+// It returns an error if "limit" is not between 1 and MaxPageSize.
+func newPager[T any](offset *int64, limit *int64, list func(ctx context.Context, offset int64, limit int64) ([]T, *int64, error)) (*Pager[T], error) {
+	pager := &Pager[T]{
+		list:     list,
+		hasNext:  true,
+		pageSize: DefaultPageSize,
+	}
+	if offset != nil {
+		pager.offset = *offset
+	}
+	if limit != nil {
+		if err := validatePageSize(*limit); err != nil {
+			return nil, err
+		}
+		pager.pageSize = *limit
+	}
+	return pager, nil
+}
+
+// validatePageSize returns an error if "pageSize" is not between 1 and MaxPageSize.
+func validatePageSize(pageSize int64) error {
+	if pageSize < 1 || pageSize > MaxPageSize {
+		return fmt.Errorf("page size %d is not between 1 and %d", pageSize, MaxPageSize)
+	}
+	return nil
+}
+
+// SetPageSize sets the number of items requested per page, between 1 and MaxPageSize. With any other value,
+// the next call to Next fails without sending a request.
+func (pager *Pager[T]) SetPageSize(pageSize int64) *Pager[T] {
+	pager.pageSize = pageSize
+	return pager
+}
+
+// SetMaxItems sets the maximum number of items returned by the pager. A value of 0 means no maximum.
+func (pager *Pager[T]) SetMaxItems(maxItems int64) *Pager[T] {
+	pager.maxItems = maxItems
+	pager.hasNext = pager.hasNext && (maxItems <= 0 || pager.count < maxItems)
+	return pager
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *Pager[T]) HasNext() bool {
+	return pager.hasNext
+}
+
+// Next returns the next page of results.
+func (pager *Pager[T]) Next() ([]T, error) {
+	return pager.NextWithContext(context.Background())
+}
+
+// NextWithContext returns the next page of results using the specified Context.
+// If the operation fails, the pager does not advance and the same page can be requested again.
+func (pager *Pager[T]) NextWithContext(ctx context.Context) (page []T, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	if err = validatePageSize(pager.pageSize); err != nil {
+		return nil, err
+	}
+	limit := pager.pageSize
+	if pager.maxItems > 0 && pager.maxItems-pager.count < limit {
+		limit = pager.maxItems - pager.count
+	}
+
+	page, totalCount, err := pager.list(ctx, pager.offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(page)) > limit {
+		page = page[:limit]
+	}
+	if totalCount != nil {
+		pager.totalCount = totalCount
+	}
+	pager.offset += int64(len(page))
+	pager.count += int64(len(page))
+
+	switch {
+	case len(page) == 0:
+		pager.hasNext = false
+	case totalCount != nil:
+		pager.hasNext = pager.offset < *totalCount
+	default:
+		pager.hasNext = int64(len(page)) == limit
+	}
+	if pager.maxItems > 0 && pager.count >= pager.maxItems {
+		pager.hasNext = false
+	}
+	return page, nil
+}
+
+// total returns the total number of items reported by the last page or, if the operation does not report it,
+// the number of items returned so far.
+func (pager *Pager[T]) total() int64 {
+	if pager.totalCount != nil {
+		return *pager.totalCount
+	}
+	return pager.count
+}
+
+// All returns all results by invoking Next repeatedly until all pages of results have been retrieved.
+func (pager *Pager[T]) All() ([]T, error) {
+	return pager.AllWithContext(context.Background())
+}
+
+// AllWithContext returns all results by invoking NextWithContext repeatedly until all pages of results
+// have been retrieved.
+func (pager *Pager[T]) AllWithContext(ctx context.Context) (allItems []T, err error) {
+	for pager.HasNext() {
+		var nextPage []T
+		nextPage, err = pager.NextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// Items returns an iterator over the remaining results, retrieving pages as needed using the specified Context.
+// If a page cannot be retrieved, the iterator yields the error with a zero item and then stops.
+func (pager *Pager[T]) Items(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for pager.HasNext() {
+			page, err := pager.NextWithContext(ctx)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range page {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// NewWorkspacesPager returns a pager for the ListWorkspaces operation.
+// The first page starts at the Offset specified in listWorkspacesOptions, and Limit, between 1 and MaxPageSize,
+// is used as the default page size.
+func (schematics *SchematicsV1) NewWorkspacesPager(listWorkspacesOptions *ListWorkspacesOptions) (pager *Pager[WorkspaceResponse], err error) {
+	err = core.ValidateStruct(listWorkspacesOptions, "listWorkspacesOptions")
+	if err != nil {
+		return
+	}
+
+	options := *listWorkspacesOptions
+	pager, err = newPager(options.Offset, options.Limit, func(ctx context.Context, offset int64, limit int64) ([]WorkspaceResponse, *int64, error) {
+		pageOptions := options
+		pageOptions.Offset = &offset
+		pageOptions.Limit = &limit
+		result, _, err := schematics.ListWorkspacesWithContext(ctx, &pageOptions)
+		if err != nil || result == nil {
+			return nil, nil, err
+		}
+		return result.Workspaces, result.Count, nil
+	})
+	return
+}
+
+// NewActionsPager returns a pager for the ListActions operation.
+// The first page starts at the Offset specified in listActionsOptions, and Limit, between 1 and MaxPageSize,
+// is used as the default page size.
+func (schematics *SchematicsV1) NewActionsPager(listActionsOptions *ListActionsOptions) (pager *Pager[ActionLite], err error) {
+	err = core.ValidateStruct(listActionsOptions, "listActionsOptions")
+	if err != nil {
+		return
+	}
+
+	options := *listActionsOptions
+	pager, err = newPager(options.Offset, options.Limit, func(ctx context.Context, offset int64, limit int64) ([]ActionLite, *int64, error) {
+		pageOptions := options
+		pageOptions.Offset = &offset
+		pageOptions.Limit = &limit
+		result, _, err := schematics.ListActionsWithContext(ctx, &pageOptions)
+		if err != nil || result == nil {
+			return nil, nil, err
+		}
+		return result.Actions, result.TotalCount, nil
+	})
+	return
+}
+
+// NewJobsPager returns a pager for the ListJobs operation.
+// The first page starts at the Offset specified in listJobsOptions, and Limit, between 1 and MaxPageSize,
+// is used as the default page size.
+func (schematics *SchematicsV1) NewJobsPager(listJobsOptions *ListJobsOptions) (pager *Pager[JobLite], err error) {
+	err = core.ValidateStruct(listJobsOptions, "listJobsOptions")
+	if err != nil {
+		return
+	}
+
+	options := *listJobsOptions
+	pager, err = newPager(options.Offset, options.Limit, func(ctx context.Context, offset int64, limit int64) ([]JobLite, *int64, error) {
+		pageOptions := options
+		pageOptions.Offset = &offset
+		pageOptions.Limit = &limit
+		result, _, err := schematics.ListJobsWithContext(ctx, &pageOptions)
+		if err != nil || result == nil {
+			return nil, nil, err
+		}
+		return result.Jobs, result.TotalCount, nil
+	})
+	return
+}
+
+// NewWorkspaceActivitiesPager returns a pager for the ListWorkspaceActivities operation.
+// The first page starts at the Offset specified in listWorkspaceActivitiesOptions, and Limit, between 1 and MaxPageSize,
+// is used as the default page size.
+func (schematics *SchematicsV1) NewWorkspaceActivitiesPager(listWorkspaceActivitiesOptions *ListWorkspaceActivitiesOptions) (pager *Pager[WorkspaceActivity], err error) {
+	err = core.ValidateStruct(listWorkspaceActivitiesOptions, "listWorkspaceActivitiesOptions")
+	if err != nil {
+		return
+	}
+
+	options := *listWorkspaceActivitiesOptions
+	pager, err = newPager(options.Offset, options.Limit, func(ctx context.Context, offset int64, limit int64) ([]WorkspaceActivity, *int64, error) {
+		pageOptions := options
+		pageOptions.Offset = &offset
+		pageOptions.Limit = &limit
+		result, _, err := schematics.ListWorkspaceActivitiesWithContext(ctx, &pageOptions)
+		if err != nil || result == nil {
+			return nil, nil, err
+		}
+		return result.Actions, nil, nil
+	})
+	return
+}
+
+// NewBlueprintPager returns a pager for the ListBlueprint operation.
+// The first page starts at the Offset specified in listBlueprintOptions, and Limit, between 1 and MaxPageSize,
+// is used as the default page size.
+func (schematics *SchematicsV1) NewBlueprintPager(listBlueprintOptions *ListBlueprintOptions) (pager *Pager[BlueprintLite], err error) {
+	err = core.ValidateStruct(listBlueprintOptions, "listBlueprintOptions")
+	if err != nil {
+		return
+	}
+
+	options := *listBlueprintOptions
+	pager, err = newPager(options.Offset, options.Limit, func(ctx context.Context, offset int64, limit int64) ([]BlueprintLite, *int64, error) {
+		pageOptions := options
+		pageOptions.Offset = &offset
+		pageOptions.Limit = &limit
+		result, _, err := schematics.ListBlueprintWithContext(ctx, &pageOptions)
+		if err != nil || result == nil {
+			return nil, nil, err
+		}
+		return result.Blueprints, result.TotalCount, nil
+	})
+	return
+}
+
+// NewInventoriesPager returns a pager for the ListInventories operation.
+// The first page starts at the Offset specified in listInventoriesOptions, and Limit, between 1 and MaxPageSize,
+// is used as the default page size.
+func (schematics *SchematicsV1) NewInventoriesPager(listInventoriesOptions *ListInventoriesOptions) (pager *Pager[InventoryResourceRecord], err error) {
+	err = core.ValidateStruct(listInventoriesOptions, "listInventoriesOptions")
+	if err != nil {
+		return
+	}
+
+	options := *listInventoriesOptions
+	pager, err = newPager(options.Offset, options.Limit, func(ctx context.Context, offset int64, limit int64) ([]InventoryResourceRecord, *int64, error) {
+		pageOptions := options
+		pageOptions.Offset = &offset
+		pageOptions.Limit = &limit
+		result, _, err := schematics.ListInventoriesWithContext(ctx, &pageOptions)
+		if err != nil || result == nil {
+			return nil, nil, err
+		}
+		return result.Inventories, result.TotalCount, nil
+	})
+	return
+}
+
+// NewResourceQueryPager returns a pager for the ListResourceQuery operation.
+// The first page starts at the Offset specified in listResourceQueryOptions, and Limit, between 1 and MaxPageSize,
+// is used as the default page size.
+func (schematics *SchematicsV1) NewResourceQueryPager(listResourceQueryOptions *ListResourceQueryOptions) (pager *Pager[ResourceQueryRecord], err error) {
+	err = core.ValidateStruct(listResourceQueryOptions, "listResourceQueryOptions")
+	if err != nil {
+		return
+	}
+
+	options := *listResourceQueryOptions
+	pager, err = newPager(options.Offset, options.Limit, func(ctx context.Context, offset int64, limit int64) ([]ResourceQueryRecord, *int64, error) {
+		pageOptions := options
+		pageOptions.Offset = &offset
+		pageOptions.Limit = &limit
+		result, _, err := schematics.ListResourceQueryWithContext(ctx, &pageOptions)
+		if err != nil || result == nil {
+			return nil, nil, err
+		}
+		return result.ResourceQueries, result.TotalCount, nil
+	})
+	return
+}
+
+// NewAgentPager returns a pager for the ListAgent operation.
+// The first page starts at the Offset specified in listAgentOptions, and Limit, between 1 and MaxPageSize,
+// is used as the default page size.
+func (schematics *SchematicsV1) NewAgentPager(listAgentOptions *ListAgentOptions) (pager *Pager[Agent], err error) {
+	err = core.ValidateStruct(listAgentOptions, "listAgentOptions")
+	if err != nil {
+		return
+	}
+
+	options := *listAgentOptions
+	pager, err = newPager(options.Offset, options.Limit, func(ctx context.Context, offset int64, limit int64) ([]Agent, *int64, error) {
+		pageOptions := options
+		pageOptions.Offset = &offset
+		pageOptions.Limit = &limit
+		result, _, err := schematics.ListAgentWithContext(ctx, &pageOptions)
+		if err != nil || result == nil {
+			return nil, nil, err
+		}
+		return result.Agents, result.TotalCount, nil
+	})
+	return
+}
+
+// NewAgentDataPager returns a pager for the ListAgentData operation.
+// The first page starts at the Offset specified in listAgentDataOptions, and Limit, between 1 and MaxPageSize,
+// is used as the default page size.
+func (schematics *SchematicsV1) NewAgentDataPager(listAgentDataOptions *ListAgentDataOptions) (pager *Pager[AgentDataLite], err error) {
+	err = core.ValidateStruct(listAgentDataOptions, "listAgentDataOptions")
+	if err != nil {
+		return
+	}
+
+	options := *listAgentDataOptions
+	pager, err = newPager(options.Offset, options.Limit, func(ctx context.Context, offset int64, limit int64) ([]AgentDataLite, *int64, error) {
+		pageOptions := options
+		pageOptions.Offset = &offset
+		pageOptions.Limit = &limit
+		result, _, err := schematics.ListAgentDataWithContext(ctx, &pageOptions)
+		if err != nil || result == nil {
+			return nil, nil, err
+		}
+		return result.Agents, result.TotalCount, nil
+	})
+	return
+}
+
+// NewPolicyPager returns a pager for the ListPolicy operation.
+// The first page starts at the Offset specified in listPolicyOptions, and Limit, between 1 and MaxPageSize,
+// is used as the default page size.
+func (schematics *SchematicsV1) NewPolicyPager(listPolicyOptions *ListPolicyOptions) (pager *Pager[PolicyLite], err error) {
+	err = core.ValidateStruct(listPolicyOptions, "listPolicyOptions")
+	if err != nil {
+		return
+	}
+
+	options := *listPolicyOptions
+	pager, err = newPager(options.Offset, options.Limit, func(ctx context.Context, offset int64, limit int64) ([]PolicyLite, *int64, error) {
+		pageOptions := options
+		pageOptions.Offset = &offset
+		pageOptions.Limit = &limit
+		result, _, err := schematics.ListPolicyWithContext(ctx, &pageOptions)
+		if err != nil || result == nil {
+			return nil, nil, err
+		}
+		return result.Policies, result.TotalCount, nil
+	})
+	return
+}
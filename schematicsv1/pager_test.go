@@ -0,0 +1,192 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Pager`, func() {
+	var testServer *httptest.Server
+	var schematicsService *schematicsv1.SchematicsV1
+	var requestedPages []string
+	var failAtOffset int
+
+	BeforeEach(func() {
+		requestedPages = nil
+		failAtOffset = -1
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			offset, _ := strconv.Atoi(req.URL.Query().Get("offset"))
+			limit, _ := strconv.Atoi(req.URL.Query().Get("limit"))
+			requestedPages = append(requestedPages, fmt.Sprintf("%d/%d", offset, limit))
+			if offset == failAtOffset {
+				res.WriteHeader(500)
+				return
+			}
+
+			var ids []string
+			for i := offset; i < offset+limit && i < 5; i++ {
+				ids = append(ids, fmt.Sprintf(`{"id": "item-%d"}`, i))
+			}
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			if strings.HasSuffix(req.URL.Path, "/actions") {
+				fmt.Fprintf(res, `{"actions": [%s]}`, strings.Join(ids, ", "))
+			} else {
+				fmt.Fprintf(res, `{"count": 5, "limit": %d, "offset": %d, "workspaces": [%s]}`, limit, offset, strings.Join(ids, ", "))
+			}
+		}))
+
+		var serviceErr error
+		schematicsService, serviceErr = schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Invoke NextWithContext until HasNext returns false`, func() {
+		pager, err := schematicsService.NewWorkspacesPager(&schematicsv1.ListWorkspacesOptions{Limit: core.Int64Ptr(2)})
+		Expect(err).To(BeNil())
+
+		var pages [][]schematicsv1.WorkspaceResponse
+		for pager.HasNext() {
+			page, err := pager.NextWithContext(context.Background())
+			Expect(err).To(BeNil())
+			pages = append(pages, page)
+		}
+		Expect(pages).To(HaveLen(3))
+		Expect(pages[2]).To(HaveLen(1))
+		Expect(*pages[2][0].ID).To(Equal("item-4"))
+		Expect(requestedPages).To(Equal([]string{"0/2", "2/2", "4/2"}))
+
+		page, err := pager.Next()
+		Expect(err).ToNot(BeNil())
+		Expect(page).To(BeNil())
+	})
+	It(`Invoke All with a starting offset and page size`, func() {
+		listWorkspacesOptions := &schematicsv1.ListWorkspacesOptions{Offset: core.Int64Ptr(1)}
+		pager, err := schematicsService.NewWorkspacesPager(listWorkspacesOptions)
+		Expect(err).To(BeNil())
+
+		allItems, err := pager.SetPageSize(3).All()
+		Expect(err).To(BeNil())
+		Expect(allItems).To(HaveLen(4))
+		Expect(requestedPages).To(Equal([]string{"1/3", "4/3"}))
+		Expect(listWorkspacesOptions.Limit).To(BeNil())
+	})
+	It(`Invoke All with a maximum number of items`, func() {
+		pager, err := schematicsService.NewWorkspacesPager(&schematicsv1.ListWorkspacesOptions{})
+		Expect(err).To(BeNil())
+
+		allItems, err := pager.SetPageSize(2).SetMaxItems(3).All()
+		Expect(err).To(BeNil())
+		Expect(allItems).To(HaveLen(3))
+		Expect(requestedPages).To(Equal([]string{"0/2", "2/1"}))
+		Expect(pager.HasNext()).To(BeFalse())
+	})
+	It(`Range over Items`, func() {
+		pager, err := schematicsService.NewWorkspacesPager(&schematicsv1.ListWorkspacesOptions{Limit: core.Int64Ptr(2)})
+		Expect(err).To(BeNil())
+
+		var ids []string
+		for workspace, err := range pager.Items(context.Background()) {
+			Expect(err).To(BeNil())
+			ids = append(ids, *workspace.ID)
+			if len(ids) == 3 {
+				break
+			}
+		}
+		Expect(ids).To(Equal([]string{"item-0", "item-1", "item-2"}))
+		Expect(requestedPages).To(Equal([]string{"0/2", "2/2"}))
+	})
+	It(`Range over Items with error`, func() {
+		failAtOffset = 2
+		pager, err := schematicsService.NewWorkspacesPager(&schematicsv1.ListWorkspacesOptions{Limit: core.Int64Ptr(2)})
+		Expect(err).To(BeNil())
+
+		var ids []string
+		var iterErr error
+		for workspace, err := range pager.Items(context.Background()) {
+			if err != nil {
+				iterErr = err
+				continue
+			}
+			ids = append(ids, *workspace.ID)
+		}
+		Expect(ids).To(HaveLen(2))
+		Expect(iterErr).ToNot(BeNil())
+		Expect(pager.HasNext()).To(BeTrue())
+	})
+	It(`Invoke All for an operation without a total count`, func() {
+		pager, err := schematicsService.NewWorkspaceActivitiesPager(schematicsService.NewListWorkspaceActivitiesOptions("testString"))
+		Expect(err).To(BeNil())
+
+		allItems, err := pager.SetPageSize(5).All()
+		Expect(err).To(BeNil())
+		Expect(allItems).To(HaveLen(5))
+		Expect(requestedPages).To(Equal([]string{"0/5", "5/5"}))
+	})
+	It(`Invoke NewWorkspacesPager with a page size above the default`, func() {
+		pager, err := schematicsService.NewWorkspacesPager(&schematicsv1.ListWorkspacesOptions{Limit: core.Int64Ptr(500)})
+		Expect(err).To(BeNil())
+
+		allItems, err := pager.All()
+		Expect(err).To(BeNil())
+		Expect(allItems).To(HaveLen(5))
+		Expect(requestedPages).To(Equal([]string{"0/500"}))
+
+		pager, err = schematicsService.NewWorkspacesPager(&schematicsv1.ListWorkspacesOptions{Limit: core.Int64Ptr(schematicsv1.MaxPageSize)})
+		Expect(err).To(BeNil())
+		Expect(pager).ToNot(BeNil())
+	})
+	It(`Invoke NewWorkspacesPager with error: invalid page size`, func() {
+		for _, limit := range []int64{0, -1, schematicsv1.MaxPageSize + 1} {
+			pager, err := schematicsService.NewWorkspacesPager(&schematicsv1.ListWorkspacesOptions{Limit: core.Int64Ptr(limit)})
+			Expect(err).ToNot(BeNil())
+			Expect(pager).To(BeNil())
+		}
+
+		pager, err := schematicsService.NewJobsPager(&schematicsv1.ListJobsOptions{})
+		Expect(err).To(BeNil())
+		page, err := pager.SetPageSize(schematicsv1.MaxPageSize + 1).Next()
+		Expect(err).ToNot(BeNil())
+		Expect(page).To(BeNil())
+		Expect(pager.HasNext()).To(BeTrue())
+		Expect(requestedPages).To(BeEmpty())
+	})
+	It(`Invoke NewWorkspacesPager with error: nil options`, func() {
+		pager, err := schematicsService.NewWorkspacesPager(nil)
+		Expect(err).ToNot(BeNil())
+		Expect(pager).To(BeNil())
+	})
+})
@@ -0,0 +1,286 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Operation classes used by the default classification of RateLimiter.
+const (
+	// OperationClassRead : Operations that use the GET or HEAD method.
+	OperationClassRead = "read"
+
+	// OperationClassJob : Operations that submit a job, e.g. CreateJob or ApplyWorkspaceCommand.
+	OperationClassJob = "job"
+
+	// OperationClassWrite : All other operations.
+	OperationClassWrite = "write"
+)
+
+// DefaultMaxRateLimitBackoff is the default maximum time a RateLimiter pauses an operation class after a 429 response.
+const DefaultMaxRateLimitBackoff = time.Minute
+
+// jobOperations are the operations that submit a job.
+var jobOperations = map[string]bool{
+	"ApplyWorkspaceCommand":      true,
+	"CreateJob":                  true,
+	"CreateWorkspaceDeletionJob": true,
+	"DeployAgentJob":             true,
+	"DestroyWorkspaceCommand":    true,
+	"ExecuteResourceQuery":       true,
+	"HealthCheckAgentJob":        true,
+	"PlanWorkspaceCommand":       true,
+	"PrsAgentJob":                true,
+	"RefreshWorkspaceCommand":    true,
+	"RunWorkspaceCommands":       true,
+	"UpdateJob":                  true,
+}
+
+// OperationClass returns the default class of a call: OperationClassRead, OperationClassJob or OperationClassWrite.
+func OperationClass(call *Call) string {
+	switch {
+	case jobOperations[call.OperationID]:
+		return OperationClassJob
+	case call.Request.Method == http.MethodGet || call.Request.Method == http.MethodHead:
+		return OperationClassRead
+	default:
+		return OperationClassWrite
+	}
+}
+
+// RateLimit : The rate limit of an operation class.
+type RateLimit struct {
+	// The sustained number of requests per second. A rate of 0 disables the limit.
+	Rate float64
+
+	// The maximum number of requests that can be sent in a single burst; 1 if less than 1.
+	Burst int
+}
+
+// RateLimiterOptions : The options for NewRateLimiter.
+type RateLimiterOptions struct {
+	// The rate limit of the operation classes that have no entry in Limits.
+	Default RateLimit
+
+	// The rate limits of individual operation classes.
+	Limits map[string]RateLimit
+
+	// The function that returns the operation class of a call; OperationClass if nil.
+	Classify func(call *Call) string
+
+	// The maximum time an operation class is paused after a 429 response without a Retry-After header;
+	// DefaultMaxRateLimitBackoff if 0.
+	MaxBackoff time.Duration
+}
+
+// RateLimiter : A client-side rate limiter that applies a token bucket per operation class to the requests sent by
+// a SchematicsV1 instance.
+//
+// The limiter adapts to throttling by the service: when a request receives a 429 response, the operation class is
+// paused until the time given by the Retry-After header (or for an exponentially increasing backoff if the header is
+// absent), and its rate is halved, down to a tenth of the configured rate. The rate recovers gradually with each
+// successful response.
+type RateLimiter struct {
+	classify   func(call *Call) string
+	maxBackoff time.Duration
+
+	mutex   sync.Mutex
+	limit   RateLimit
+	limits  map[string]RateLimit
+	buckets map[string]*tokenBucket
+}
+
+// tokenBucket holds the state of the token bucket of an operation class.
+type tokenBucket struct {
+	limit  RateLimit
+	rate   float64
+	tokens float64
+	last   time.Time
+
+	// The time until which the bucket is paused after a 429 response, and the number of consecutive 429 responses.
+	pausedUntil time.Time
+	throttled   int
+}
+
+// NewRateLimiter constructs a RateLimiter. Install it with SchematicsV1.Use(limiter.Interceptor()), or use
+// SchematicsV1.EnableRateLimiting.
+func NewRateLimiter(options *RateLimiterOptions) *RateLimiter {
+	if options == nil {
+		options = &RateLimiterOptions{}
+	}
+	limiter := &RateLimiter{
+		classify:   options.Classify,
+		maxBackoff: options.MaxBackoff,
+		limit:      options.Default,
+		limits:     make(map[string]RateLimit),
+		buckets:    make(map[string]*tokenBucket),
+	}
+	if limiter.classify == nil {
+		limiter.classify = OperationClass
+	}
+	if limiter.maxBackoff <= 0 {
+		limiter.maxBackoff = DefaultMaxRateLimitBackoff
+	}
+	for class, limit := range options.Limits {
+		limiter.limits[class] = limit
+	}
+	return limiter
+}
+
+// EnableRateLimiting installs a RateLimiter constructed with "options" in the interceptor chain of the instance and
+// returns it. The limiter is shared with the clones of the instance.
+func (schematics *SchematicsV1) EnableRateLimiting(options *RateLimiterOptions) *RateLimiter {
+	limiter := NewRateLimiter(options)
+	schematics.Use(limiter.Interceptor())
+	return limiter
+}
+
+// SetLimit changes the rate limit of an operation class.
+func (limiter *RateLimiter) SetLimit(class string, limit RateLimit) {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	limiter.limits[class] = limit
+	if bucket, ok := limiter.buckets[class]; ok {
+		bucket.limit = limit
+		bucket.rate = limit.Rate
+	}
+}
+
+// Interceptor returns the interceptor that applies the limiter to the requests of a SchematicsV1 instance.
+func (limiter *RateLimiter) Interceptor() Interceptor {
+	return func(call *Call, next Handler) (*http.Response, error) {
+		class := limiter.classify(call)
+		if err := limiter.Wait(call.Request.Context(), class); err != nil {
+			return nil, err
+		}
+		response, err := next(call)
+		if err == nil {
+			limiter.observe(class, response)
+		}
+		return response, err
+	}
+}
+
+// Wait blocks until a request of the operation class may be sent, or until the context is done.
+func (limiter *RateLimiter) Wait(ctx context.Context, class string) error {
+	for {
+		delay := limiter.reserve(class)
+		if delay <= 0 {
+			return nil
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve takes a token from the bucket of the operation class and returns 0, or returns the time to wait
+// before trying again.
+func (limiter *RateLimiter) reserve(class string) time.Duration {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	bucket := limiter.bucket(class)
+	now := time.Now()
+	if now.Before(bucket.pausedUntil) {
+		return bucket.pausedUntil.Sub(now)
+	}
+	// The bucket does not refill while it is paused.
+	if bucket.last.Before(bucket.pausedUntil) {
+		bucket.last = bucket.pausedUntil
+	}
+	if bucket.rate <= 0 {
+		return 0
+	}
+
+	burst := float64(max(bucket.limit.Burst, 1))
+	bucket.tokens = math.Min(burst, bucket.tokens+now.Sub(bucket.last).Seconds()*bucket.rate)
+	bucket.last = now
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		return 0
+	}
+	return time.Duration((1 - bucket.tokens) / bucket.rate * float64(time.Second))
+}
+
+// observe adapts the bucket of the operation class to the response of a request.
+func (limiter *RateLimiter) observe(class string, response *http.Response) {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	bucket := limiter.bucket(class)
+	if response.StatusCode != http.StatusTooManyRequests {
+		bucket.throttled = 0
+		// Recover a tenth of the configured rate per successful response.
+		bucket.rate = math.Min(bucket.limit.Rate, bucket.rate+bucket.limit.Rate/10)
+		return
+	}
+
+	bucket.throttled++
+	bucket.rate = math.Max(bucket.rate/2, bucket.limit.Rate/10)
+	bucket.tokens = 0
+	delay, ok := parseRetryAfter(response.Header.Get("Retry-After"))
+	if !ok {
+		delay = min(time.Second<<min(bucket.throttled-1, 16), limiter.maxBackoff)
+	}
+	if pausedUntil := time.Now().Add(delay); pausedUntil.After(bucket.pausedUntil) {
+		bucket.pausedUntil = pausedUntil
+	}
+}
+
+// bucket returns the bucket of the operation class, creating it if needed. The caller must hold the mutex.
+func (limiter *RateLimiter) bucket(class string) *tokenBucket {
+	bucket, ok := limiter.buckets[class]
+	if !ok {
+		limit, ok := limiter.limits[class]
+		if !ok {
+			limit = limiter.limit
+		}
+		bucket = &tokenBucket{
+			limit:  limit,
+			rate:   limit.Rate,
+			tokens: float64(max(limit.Burst, 1)),
+			last:   time.Now(),
+		}
+		limiter.buckets[class] = bucket
+	}
+	return bucket
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either a number of seconds or an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
@@ -0,0 +1,167 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`RateLimiter`, func() {
+	var testServer *httptest.Server
+	var schematicsService *schematicsv1.SchematicsV1
+	var mutex sync.Mutex
+	var throttled int
+	var retryAfter string
+	var requestTimes []time.Time
+
+	BeforeEach(func() {
+		throttled = 0
+		retryAfter = ""
+		requestTimes = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			requestTimes = append(requestTimes, time.Now())
+			res.Header().Set("Content-type", "application/json")
+			if throttled > 0 {
+				throttled--
+				if retryAfter != "" {
+					res.Header().Set("Retry-After", retryAfter)
+				}
+				res.WriteHeader(429)
+				return
+			}
+			res.WriteHeader(200)
+			res.Write([]byte(`{"id": "testString"}`))
+		}))
+
+		var serviceErr error
+		schematicsService, serviceErr = schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Classify operations`, func() {
+		classes := make(map[string]string)
+		schematicsService.Use(func(call *schematicsv1.Call, next schematicsv1.Handler) (*http.Response, error) {
+			classes[call.OperationID] = schematicsv1.OperationClass(call)
+			return next(call)
+		})
+
+		_, _, operationErr := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("testString"))
+		Expect(operationErr).To(BeNil())
+		_, _, operationErr = schematicsService.CreateJob(schematicsService.NewCreateJobOptions("testString"))
+		Expect(operationErr).To(BeNil())
+		_, _, operationErr = schematicsService.ApplyWorkspaceCommand(schematicsService.NewApplyWorkspaceCommandOptions("testString", "testString"))
+		Expect(operationErr).To(BeNil())
+		_, _, operationErr = schematicsService.CreateWorkspace(schematicsService.NewCreateWorkspaceOptions())
+		Expect(operationErr).To(BeNil())
+		Expect(classes).To(Equal(map[string]string{
+			"GetWorkspace":          schematicsv1.OperationClassRead,
+			"CreateJob":             schematicsv1.OperationClassJob,
+			"ApplyWorkspaceCommand": schematicsv1.OperationClassJob,
+			"CreateWorkspace":       schematicsv1.OperationClassWrite,
+		}))
+	})
+	It(`Limit the rate per operation class across clones`, func() {
+		schematicsService.EnableRateLimiting(&schematicsv1.RateLimiterOptions{
+			Limits: map[string]schematicsv1.RateLimit{
+				schematicsv1.OperationClassJob: {Rate: 10, Burst: 1},
+			},
+		})
+		clone := schematicsService.Clone()
+
+		start := time.Now()
+		for i := 0; i < 5; i++ {
+			_, _, operationErr := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("testString"))
+			Expect(operationErr).To(BeNil())
+		}
+		Expect(time.Since(start)).To(BeNumerically("<", 100*time.Millisecond))
+
+		start = time.Now()
+		_, _, operationErr := schematicsService.CreateJob(schematicsService.NewCreateJobOptions("testString"))
+		Expect(operationErr).To(BeNil())
+		_, _, operationErr = clone.CreateJob(clone.NewCreateJobOptions("testString"))
+		Expect(operationErr).To(BeNil())
+		_, _, operationErr = schematicsService.CreateJob(schematicsService.NewCreateJobOptions("testString"))
+		Expect(operationErr).To(BeNil())
+		Expect(time.Since(start)).To(BeNumerically(">=", 180*time.Millisecond))
+	})
+	It(`Honour Retry-After`, func() {
+		schematicsService.EnableRateLimiting(nil)
+		throttled = 1
+		retryAfter = "1"
+
+		_, _, operationErr := schematicsService.GetJob(schematicsService.NewGetJobOptions("testString"))
+		Expect(schematicsv1.IsRateLimited(operationErr)).To(BeTrue())
+		_, _, operationErr = schematicsService.GetJob(schematicsService.NewGetJobOptions("testString"))
+		Expect(operationErr).To(BeNil())
+
+		Expect(requestTimes).To(HaveLen(2))
+		Expect(requestTimes[1].Sub(requestTimes[0])).To(BeNumerically(">=", 900*time.Millisecond))
+	})
+	It(`Do not refill the bucket while it is paused`, func() {
+		schematicsService.EnableRateLimiting(&schematicsv1.RateLimiterOptions{
+			Limits: map[string]schematicsv1.RateLimit{
+				schematicsv1.OperationClassRead: {Rate: 10, Burst: 3},
+			},
+		})
+		throttled = 1
+		retryAfter = "1"
+
+		_, _, operationErr := schematicsService.GetJob(schematicsService.NewGetJobOptions("testString"))
+		Expect(schematicsv1.IsRateLimited(operationErr)).To(BeTrue())
+		for i := 0; i < 3; i++ {
+			_, _, operationErr = schematicsService.GetJob(schematicsService.NewGetJobOptions("testString"))
+			Expect(operationErr).To(BeNil())
+		}
+
+		// The bucket is empty when the pause ends, so the requests are spaced at the halved rate.
+		Expect(requestTimes).To(HaveLen(4))
+		Expect(requestTimes[1].Sub(requestTimes[0])).To(BeNumerically(">=", 1100*time.Millisecond))
+		Expect(requestTimes[3].Sub(requestTimes[0])).To(BeNumerically(">=", 1400*time.Millisecond))
+	})
+	It(`Stop waiting when the context is done`, func() {
+		limiter := schematicsService.EnableRateLimiting(nil)
+		limiter.SetLimit(schematicsv1.OperationClassRead, schematicsv1.RateLimit{Rate: 0.1, Burst: 1})
+
+		_, _, operationErr := schematicsService.GetJob(schematicsService.NewGetJobOptions("testString"))
+		Expect(operationErr).To(BeNil())
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, _, operationErr = schematicsService.GetJobWithContext(ctx, schematicsService.NewGetJobOptions("testString"))
+		Expect(operationErr).ToNot(BeNil())
+		Expect(operationErr.Error()).To(ContainSubstring("context deadline exceeded"))
+		Expect(requestTimes).To(HaveLen(1))
+	})
+})
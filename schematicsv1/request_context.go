@@ -0,0 +1,114 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"context"
+	"maps"
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Header names of the correlation and transaction IDs of a request.
+const (
+	// HeaderNameCorrelationID is the request header that carries the correlation ID set with WithCorrelationID.
+	HeaderNameCorrelationID = "X-Correlation-Id"
+
+	// HeaderNameTransactionID is the response header that carries the transaction ID assigned by the service.
+	HeaderNameTransactionID = "Transaction-Id"
+)
+
+// contextKey is the type of the keys of the values stored in a context by this package.
+type contextKey int
+
+const (
+	correlationIDKey contextKey = iota
+	headersKey
+)
+
+// WithCorrelationID returns a copy of "ctx" that carries a correlation ID. Requests sent with the context by the
+// operations of a SchematicsV1 instance include the ID in the X-Correlation-Id header.
+func WithCorrelationID(ctx context.Context, correlationID string) context.Context {
+	return context.WithValue(ctx, correlationIDKey, correlationID)
+}
+
+// CorrelationIDFromContext returns the correlation ID carried by "ctx", or an empty string if there is none.
+func CorrelationIDFromContext(ctx context.Context) string {
+	correlationID, _ := ctx.Value(correlationIDKey).(string)
+	return correlationID
+}
+
+// WithHeaders returns a copy of "ctx" that carries additional request headers. Requests sent with the context by
+// the operations of a SchematicsV1 instance include the headers. The headers are merged with the headers already
+// carried by "ctx", replacing those with the same name.
+func WithHeaders(ctx context.Context, headers map[string]string) context.Context {
+	merged := make(map[string]string)
+	maps.Copy(merged, HeadersFromContext(ctx))
+	for name, value := range headers {
+		merged[http.CanonicalHeaderKey(name)] = value
+	}
+	return context.WithValue(ctx, headersKey, merged)
+}
+
+// HeadersFromContext returns the request headers carried by "ctx", or nil if there are none.
+// The returned map must not be modified.
+func HeadersFromContext(ctx context.Context) map[string]string {
+	headers, _ := ctx.Value(headersKey).(map[string]string)
+	return headers
+}
+
+// NewContextHeadersInterceptor returns an interceptor that adds the correlation ID and the headers carried by the
+// context of each request, as set with WithCorrelationID and WithHeaders, to the request headers. The operations of
+// a SchematicsV1 instance add them without the interceptor; it is needed only for the requests that are sent with
+// Service.Request through the HTTP client of the instance.
+func NewContextHeadersInterceptor() Interceptor {
+	return func(call *Call, next Handler) (*http.Response, error) {
+		addContextHeaders(call.Request)
+		return next(call)
+	}
+}
+
+// addContextHeaders adds the correlation ID and the headers carried by the context of "request" to its headers.
+// Headers that are already set on the request, e.g. with the Headers field of the options of an operation, are
+// not replaced.
+func addContextHeaders(request *http.Request) {
+	ctx := request.Context()
+	for name, value := range HeadersFromContext(ctx) {
+		if request.Header.Get(name) == "" {
+			request.Header.Set(name, value)
+		}
+	}
+	if correlationID := CorrelationIDFromContext(ctx); correlationID != "" && request.Header.Get(HeaderNameCorrelationID) == "" {
+		request.Header.Set(HeaderNameCorrelationID, correlationID)
+	}
+}
+
+// GetTransactionID returns the transaction ID that the service assigned to the request of "response", which
+// identifies the request in IBM support tickets. It returns an empty string if the response carries none.
+func GetTransactionID(response *core.DetailedResponse) string {
+	if response == nil {
+		return ""
+	}
+	return transactionID(response.Headers)
+}
+
+// transactionID returns the transaction ID carried by the response headers "headers". A correlation ID echoed by
+// the response is the one sent by the caller, not a transaction ID of the service, so it is not reported.
+func transactionID(headers http.Header) string {
+	return headers.Get(HeaderNameTransactionID)
+}
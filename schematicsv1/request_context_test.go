@@ -0,0 +1,127 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Context headers`, func() {
+	var testServer *httptest.Server
+	var schematicsService *schematicsv1.SchematicsV1
+	var receivedHeaders http.Header
+	var statusCode int
+
+	BeforeEach(func() {
+		receivedHeaders = nil
+		statusCode = 200
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			receivedHeaders = req.Header
+			res.Header().Set("Content-type", "application/json")
+			res.Header().Set("Transaction-Id", "txn-"+req.Header.Get("X-Correlation-Id"))
+			res.WriteHeader(statusCode)
+			res.Write([]byte(`{"id": "testString"}`))
+		}))
+
+		var serviceErr error
+		schematicsService, serviceErr = schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Add the correlation ID and headers of the context`, func() {
+		ctx := schematicsv1.WithCorrelationID(context.Background(), "corr-1")
+		ctx = schematicsv1.WithHeaders(ctx, map[string]string{"x-team": "infra", "X-Tool": "deployer"})
+		ctx = schematicsv1.WithHeaders(ctx, map[string]string{"X-Tool": "auditor"})
+		Expect(schematicsv1.CorrelationIDFromContext(ctx)).To(Equal("corr-1"))
+		Expect(schematicsv1.HeadersFromContext(ctx)).To(Equal(map[string]string{"X-Team": "infra", "X-Tool": "auditor"}))
+
+		_, response, operationErr := schematicsService.GetWorkspaceWithContext(ctx, schematicsService.NewGetWorkspaceOptions("testString"))
+		Expect(operationErr).To(BeNil())
+		Expect(receivedHeaders.Get("X-Correlation-Id")).To(Equal("corr-1"))
+		Expect(receivedHeaders.Get("X-Team")).To(Equal("infra"))
+		Expect(receivedHeaders.Get("X-Tool")).To(Equal("auditor"))
+		Expect(schematicsv1.GetTransactionID(response)).To(Equal("txn-corr-1"))
+	})
+	It(`Keep the headers set with the options`, func() {
+		ctx := schematicsv1.WithHeaders(context.Background(), map[string]string{"X-Tool": "context"})
+		getWorkspaceOptions := schematicsService.NewGetWorkspaceOptions("testString")
+		getWorkspaceOptions.SetHeaders(map[string]string{"X-Tool": "options"})
+
+		_, _, operationErr := schematicsService.GetWorkspaceWithContext(ctx, getWorkspaceOptions)
+		Expect(operationErr).To(BeNil())
+		Expect(receivedHeaders.Get("X-Tool")).To(Equal("options"))
+		Expect(receivedHeaders.Get("X-Correlation-Id")).To(BeEmpty())
+	})
+	It(`Report the transaction ID of failed requests`, func() {
+		statusCode = 500
+		ctx := schematicsv1.WithCorrelationID(context.Background(), "corr-2")
+
+		_, response, operationErr := schematicsService.GetJobWithContext(ctx, schematicsService.NewGetJobOptions("testString"))
+		Expect(operationErr).ToNot(BeNil())
+		Expect(schematicsv1.GetTransactionID(response)).To(Equal("txn-corr-2"))
+		var schematicsError *schematicsv1.SchematicsError
+		Expect(errors.As(operationErr, &schematicsError)).To(BeTrue())
+		Expect(schematicsError.TransactionID).To(Equal("txn-corr-2"))
+		Expect(schematicsv1.GetTransactionID(nil)).To(BeEmpty())
+	})
+	It(`Do not report an echoed correlation ID as the transaction ID`, func() {
+		echoServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			res.Header().Set("X-Correlation-Id", req.Header.Get("X-Correlation-Id"))
+			res.WriteHeader(500)
+			res.Write([]byte(`{"message": "internal error"}`))
+		}))
+		defer echoServer.Close()
+		Expect(schematicsService.SetServiceURL(echoServer.URL)).To(Succeed())
+		ctx := schematicsv1.WithCorrelationID(context.Background(), "corr-4")
+
+		_, response, operationErr := schematicsService.GetJobWithContext(ctx, schematicsService.NewGetJobOptions("testString"))
+		Expect(operationErr).ToNot(BeNil())
+		Expect(response.Headers.Get("X-Correlation-Id")).To(Equal("corr-4"))
+		Expect(schematicsv1.GetTransactionID(response)).To(BeEmpty())
+		var schematicsError *schematicsv1.SchematicsError
+		Expect(errors.As(operationErr, &schematicsError)).To(BeTrue())
+		Expect(schematicsError.TransactionID).To(BeEmpty())
+	})
+	It(`Add the headers of the context to requests sent with Service.Request`, func() {
+		schematicsService.Use(schematicsv1.NewContextHeadersInterceptor())
+		ctx := schematicsv1.WithCorrelationID(context.Background(), "corr-3")
+
+		builder := core.NewRequestBuilder(core.GET).WithContext(ctx)
+		_, err := builder.ResolveRequestURL(testServer.URL, `/v1/version`, nil)
+		Expect(err).To(BeNil())
+		request, err := builder.Build()
+		Expect(err).To(BeNil())
+		_, err = schematicsService.Service.Request(request, nil)
+		Expect(err).To(BeNil())
+		Expect(receivedHeaders.Get("X-Correlation-Id")).To(Equal("corr-3"))
+	})
+})
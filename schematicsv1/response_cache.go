@@ -0,0 +1,505 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultCacheTTL : The default time during which a cached response without validators is served without
+// contacting the service.
+const DefaultCacheTTL = 30 * time.Second
+
+// DefaultCacheCapacity : The default number of responses kept by a LRUCacheStore.
+const DefaultCacheCapacity = 1000
+
+// DefaultCachedOperations : The operations whose responses are cached by default.
+var DefaultCachedOperations = []string{"GetWorkspace", "GetAction", "GetBlueprint", "GetJob"}
+
+// CachedResponse : A response stored by a ResponseCache.
+type CachedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"headers,omitempty"`
+	Body       []byte      `json:"body,omitempty"`
+
+	// The time at which the response was received or last revalidated.
+	StoredAt time.Time `json:"stored_at"`
+
+	// The invalidation generations of the resources addressed by the request, when the response was received.
+	Generations map[string]uint64 `json:"generations,omitempty"`
+}
+
+// ResponseCacheStore : The storage of the responses cached by a ResponseCache. Implementations must be safe for
+// concurrent use, and may evict responses at any time.
+type ResponseCacheStore interface {
+	// Get returns the response stored with "key", or nil if there is none.
+	Get(key string) (*CachedResponse, error)
+
+	// Put creates or replaces the response stored with "key".
+	Put(key string, response *CachedResponse) error
+
+	// Delete removes the response stored with "key", if any.
+	Delete(key string) error
+}
+
+// RemovalNotifyingCacheStore : A ResponseCacheStore that reports the responses that it removes. A ResponseCache
+// that uses such a store forgets the invalidation state of the resources that none of its stored responses refer
+// to; with other stores, that state grows with the number of resources that were cached.
+type RemovalNotifyingCacheStore interface {
+	ResponseCacheStore
+
+	// OnRemove registers a function that is called with each response that is evicted, deleted or replaced.
+	OnRemove(f func(key string, response *CachedResponse))
+}
+
+// LRUCacheStore : A ResponseCacheStore that keeps a bounded number of responses in memory, evicting the least
+// recently used ones.
+type LRUCacheStore struct {
+	capacity int
+
+	mutex    sync.Mutex
+	order    *list.List
+	entries  map[string]*list.Element
+	onRemove []func(key string, response *CachedResponse)
+}
+
+// lruEntry is an element of the recency list of a LRUCacheStore.
+type lruEntry struct {
+	key      string
+	response *CachedResponse
+}
+
+// NewLRUCacheStore constructs an empty LRUCacheStore that keeps up to "capacity" responses, or
+// DefaultCacheCapacity if "capacity" is not positive.
+func NewLRUCacheStore(capacity int) *LRUCacheStore {
+	if capacity <= 0 {
+		capacity = DefaultCacheCapacity
+	}
+	return &LRUCacheStore{
+		capacity: capacity,
+		order:    list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+}
+
+// Get returns the response stored with "key", or nil if there is none.
+func (store *LRUCacheStore) Get(key string) (*CachedResponse, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	if element, ok := store.entries[key]; ok {
+		store.order.MoveToFront(element)
+		return element.Value.(*lruEntry).response, nil
+	}
+	return nil, nil
+}
+
+// Put creates or replaces the response stored with "key".
+func (store *LRUCacheStore) Put(key string, response *CachedResponse) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	if element, ok := store.entries[key]; ok {
+		entry := element.Value.(*lruEntry)
+		replaced := entry.response
+		entry.response = response
+		store.order.MoveToFront(element)
+		store.removed(key, replaced)
+		return nil
+	}
+	store.entries[key] = store.order.PushFront(&lruEntry{key: key, response: response})
+	for store.order.Len() > store.capacity {
+		oldest := store.order.Back()
+		store.order.Remove(oldest)
+		entry := oldest.Value.(*lruEntry)
+		delete(store.entries, entry.key)
+		store.removed(entry.key, entry.response)
+	}
+	return nil
+}
+
+// Delete removes the response stored with "key", if any.
+func (store *LRUCacheStore) Delete(key string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	if element, ok := store.entries[key]; ok {
+		store.order.Remove(element)
+		delete(store.entries, key)
+		store.removed(key, element.Value.(*lruEntry).response)
+	}
+	return nil
+}
+
+// OnRemove registers a function that is called with each response that is evicted, deleted or replaced. The
+// function is called with the store locked, and must not call the store.
+func (store *LRUCacheStore) OnRemove(f func(key string, response *CachedResponse)) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	store.onRemove = append(store.onRemove, f)
+}
+
+// removed calls the functions registered with OnRemove. The caller must hold the mutex.
+func (store *LRUCacheStore) removed(key string, response *CachedResponse) {
+	for _, f := range store.onRemove {
+		f(key, response)
+	}
+}
+
+// Len returns the number of responses in the store.
+func (store *LRUCacheStore) Len() int {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	return store.order.Len()
+}
+
+// ResponseCacheOptions : The options for NewResponseCache.
+type ResponseCacheOptions struct {
+	// The storage of the cached responses; a LRUCacheStore with DefaultCacheCapacity if nil.
+	Store ResponseCacheStore
+
+	// The time during which a response without ETag nor Last-Modified header is served from the cache;
+	// DefaultCacheTTL if zero.
+	TTL time.Duration
+
+	// The operations whose responses are cached; DefaultCachedOperations if nil.
+	Operations []string
+}
+
+// CacheStats : The statistics of a ResponseCache.
+type CacheStats struct {
+	// The number of requests answered from the cache, including the revalidated responses.
+	Hits uint64
+
+	// The number of requests answered by the service with a new response.
+	Misses uint64
+
+	// The number of cached responses that the service confirmed as unchanged.
+	Revalidations uint64
+
+	// The number of cached responses discarded because a request changed their resource.
+	Invalidations uint64
+
+	// The number of resources and collections whose invalidation state is tracked, because they were changed or
+	// are referred to by a cached response.
+	Tracked int
+}
+
+// ResponseCache : A client-side cache of the responses of read operations, by default GetWorkspace, GetAction,
+// GetBlueprint and GetJob.
+//
+// A cached response with an ETag or Last-Modified header is revalidated with a conditional request on each read,
+// and served from the cache when the service answers 304 Not Modified. Other responses are served from the cache
+// without contacting the service for the TTL of the cache. Responses with "Cache-Control: no-store" are not
+// cached.
+//
+// The cached responses of a resource are invalidated by the POST, PUT, PATCH and DELETE requests sent through the
+// cache that address the same resource ID (see Call.ResourceIDs), e.g. an ApplyWorkspaceCommand or a CreateJob
+// for a workspace invalidates the cached GetWorkspace responses of the workspace. These requests also invalidate
+// the cached list responses of their collection, e.g. any change under /v1/workspaces invalidates the cached
+// ListWorkspaces responses. Responses are cached per authorization, so clones of an instance that use different
+// credentials do not share responses.
+type ResponseCache struct {
+	store      ResponseCacheStore
+	ttl        time.Duration
+	operations map[string]bool
+	now        func() time.Time
+
+	// Whether the store reports its removals, in which case the invalidation generations of the resources and
+	// collections that are referred to by neither a stored response nor a pending request are discarded.
+	tracking bool
+
+	mutex       sync.Mutex
+	generations map[string]uint64
+	references  map[string]int
+
+	hits, misses, revalidations, invalidations atomic.Uint64
+}
+
+// NewResponseCache constructs a ResponseCache. Install it with SchematicsV1.Use(cache.Interceptor()), or use
+// SchematicsV1.EnableResponseCache.
+func NewResponseCache(options *ResponseCacheOptions) *ResponseCache {
+	if options == nil {
+		options = &ResponseCacheOptions{}
+	}
+	cache := &ResponseCache{
+		store:       options.Store,
+		ttl:         options.TTL,
+		operations:  make(map[string]bool),
+		now:         time.Now,
+		generations: make(map[string]uint64),
+		references:  make(map[string]int),
+	}
+	if cache.store == nil {
+		cache.store = NewLRUCacheStore(DefaultCacheCapacity)
+	}
+	if store, ok := cache.store.(RemovalNotifyingCacheStore); ok {
+		cache.tracking = true
+		store.OnRemove(func(_ string, response *CachedResponse) {
+			cache.release(response.Generations)
+		})
+	}
+	if cache.ttl == 0 {
+		cache.ttl = DefaultCacheTTL
+	}
+	operations := options.Operations
+	if operations == nil {
+		operations = DefaultCachedOperations
+	}
+	for _, operationID := range operations {
+		cache.operations[operationID] = true
+	}
+	return cache
+}
+
+// EnableResponseCache installs a ResponseCache constructed with "options" in the interceptor chain of the instance
+// and returns it. The cache is shared with the clones of the instance.
+func (schematics *SchematicsV1) EnableResponseCache(options *ResponseCacheOptions) *ResponseCache {
+	cache := NewResponseCache(options)
+	schematics.Use(cache.Interceptor())
+	return cache
+}
+
+// Stats returns the statistics of the cache.
+func (cache *ResponseCache) Stats() CacheStats {
+	cache.mutex.Lock()
+	tracked := len(cache.references)
+	for key := range cache.generations {
+		if cache.references[key] == 0 {
+			tracked++
+		}
+	}
+	cache.mutex.Unlock()
+	return CacheStats{
+		Hits:          cache.hits.Load(),
+		Misses:        cache.misses.Load(),
+		Revalidations: cache.revalidations.Load(),
+		Invalidations: cache.invalidations.Load(),
+		Tracked:       tracked,
+	}
+}
+
+// Invalidate discards the cached responses of the resource "resourceID", e.g. after it was changed by another
+// client.
+func (cache *ResponseCache) Invalidate(resourceID string) {
+	cache.invalidate([]string{resourceID})
+}
+
+// Interceptor returns the interceptor that serves the requests of the cached operations from the cache, and
+// invalidates the cache on the requests that change resources.
+func (cache *ResponseCache) Interceptor() Interceptor {
+	return func(call *Call, next Handler) (*http.Response, error) {
+		switch call.Request.Method {
+		case http.MethodGet:
+			if cache.operations[call.OperationID] {
+				return cache.get(call, next)
+			}
+		case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+			// Invalidate before and after the request, so that reads that overlap it are not cached.
+			keys := generationKeys(call)
+			cache.invalidate(keys)
+			defer cache.invalidate(keys)
+		}
+		return next(call)
+	}
+}
+
+// get answers the request of "call" from the cache, or sends it and caches the response.
+func (cache *ResponseCache) get(call *Call, next Handler) (*http.Response, error) {
+	key := cacheKey(call.Request)
+	keys := generationKeys(call)
+	generations := cache.acquire(keys)
+	defer cache.release(generations)
+
+	cached, err := cache.store.Get(key)
+	if err != nil {
+		cached = nil
+	}
+	if cached != nil && !sameGenerations(cached.Generations, generations) {
+		cache.invalidations.Add(1)
+		_ = cache.store.Delete(key)
+		cached = nil
+	}
+	etag, lastModified := "", ""
+	if cached != nil {
+		etag, lastModified = cached.Header.Get("ETag"), cached.Header.Get("Last-Modified")
+		if etag == "" && lastModified == "" && cache.now().Sub(cached.StoredAt) < cache.ttl {
+			cache.hits.Add(1)
+			return cached.response(call.Request), nil
+		}
+		if etag != "" {
+			call.Request.Header.Set("If-None-Match", etag)
+		}
+		if lastModified != "" {
+			call.Request.Header.Set("If-Modified-Since", lastModified)
+		}
+	}
+
+	response, err := next(call)
+	if err != nil {
+		return response, err
+	}
+	if response.StatusCode == http.StatusNotModified && cached != nil {
+		response.Body.Close()
+		cache.hits.Add(1)
+		cache.revalidations.Add(1)
+		revalidated := *cached
+		revalidated.StoredAt = cache.now()
+		cache.put(key, &revalidated)
+		return revalidated.response(call.Request), nil
+	}
+
+	cache.misses.Add(1)
+	if response.StatusCode != http.StatusOK || strings.Contains(response.Header.Get("Cache-Control"), "no-store") {
+		return response, nil
+	}
+	body, err := io.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	response.Body = io.NopCloser(bytes.NewReader(body))
+
+	// Responses that raced with a change of their resource are not cached.
+	if sameGenerations(generations, cache.currentGenerations(keys)) {
+		cache.put(key, &CachedResponse{
+			StatusCode:  response.StatusCode,
+			Header:      response.Header.Clone(),
+			Body:        body,
+			StoredAt:    cache.now(),
+			Generations: generations,
+		})
+	}
+	return response, nil
+}
+
+// put stores "response" with "key".
+func (cache *ResponseCache) put(key string, response *CachedResponse) {
+	// The stored response refers to its generations until the store removes it.
+	cache.acquire(slices.Collect(maps.Keys(response.Generations)))
+	if err := cache.store.Put(key, response); err != nil || !cache.tracking {
+		cache.release(response.Generations)
+	}
+}
+
+// generationKeys returns the keys of the invalidation generations of the request of "call": the IDs of the
+// resources that it addresses, and the path of the collection that it lists or changes, e.g. "/v1/workspaces".
+func generationKeys(call *Call) []string {
+	var keys []string
+	for _, resourceID := range call.ResourceIDs() {
+		keys = append(keys, resourceID)
+	}
+	segments := strings.Split(strings.Trim(call.Request.URL.Path, "/"), "/")
+	if len(segments) >= 2 && (call.Request.Method != http.MethodGet || len(segments) == 2) {
+		keys = append(keys, "/"+segments[0]+"/"+segments[1])
+	}
+	return keys
+}
+
+// invalidate discards the cached responses that refer to the generations "keys".
+func (cache *ResponseCache) invalidate(keys []string) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	for _, key := range keys {
+		// Generations that are not referred to do not invalidate anything.
+		if !cache.tracking || cache.references[key] > 0 {
+			cache.generations[key]++
+		}
+	}
+}
+
+// currentGenerations returns the current invalidation generations "keys".
+func (cache *ResponseCache) currentGenerations(keys []string) map[string]uint64 {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	generations := make(map[string]uint64)
+	for _, key := range keys {
+		generations[key] = cache.generations[key]
+	}
+	return generations
+}
+
+// acquire refers to the invalidation generations "keys", so that they are kept until they are released, and
+// returns their current values.
+func (cache *ResponseCache) acquire(keys []string) map[string]uint64 {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	generations := make(map[string]uint64)
+	for _, key := range keys {
+		cache.references[key]++
+		generations[key] = cache.generations[key]
+	}
+	return generations
+}
+
+// release drops a reference to each of the invalidation generations "generations". When the store reports its
+// removals, the generations that are no longer referred to are discarded.
+func (cache *ResponseCache) release(generations map[string]uint64) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	for key := range generations {
+		if cache.references[key]--; cache.references[key] <= 0 {
+			delete(cache.references, key)
+			if cache.tracking {
+				delete(cache.generations, key)
+			}
+		}
+	}
+}
+
+// response returns a new HTTP response for "req" with the content of the cached response.
+func (cached *CachedResponse) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", cached.StatusCode, http.StatusText(cached.StatusCode)),
+		StatusCode:    cached.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        cached.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(cached.Body)),
+		ContentLength: int64(len(cached.Body)),
+		Request:       req,
+	}
+}
+
+// cacheKey returns the key of the cached responses to "req": its URL and a digest of its credentials.
+func cacheKey(req *http.Request) string {
+	digest := sha256.Sum256([]byte(req.Header.Get("Authorization")))
+	return req.URL.String() + "#" + hex.EncodeToString(digest[:8])
+}
+
+// sameGenerations returns true if two sets of invalidation generations are equal.
+func sameGenerations(a map[string]uint64, b map[string]uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for resourceID, generation := range a {
+		if other, ok := b[resourceID]; !ok || other != generation {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,218 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResponseCache`, func() {
+	var testServer *httptest.Server
+	var schematicsService *schematicsv1.SchematicsV1
+	var requests []*http.Request
+	var etag string
+	var cacheControl string
+
+	BeforeEach(func() {
+		requests = nil
+		etag = ""
+		cacheControl = ""
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			requests = append(requests, req)
+			if etag != "" {
+				res.Header().Set("ETag", etag)
+				if req.Header.Get("If-None-Match") == etag {
+					res.WriteHeader(http.StatusNotModified)
+					return
+				}
+			}
+			if cacheControl != "" {
+				res.Header().Set("Cache-Control", cacheControl)
+			}
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprintf(res, `{"id": "ws-1", "name": "response-%d"}`, len(requests))
+		}))
+
+		var serviceErr error
+		schematicsService, serviceErr = schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.BearerTokenAuthenticator{BearerToken: "token-1"},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	getWorkspaceName := func(schematicsService *schematicsv1.SchematicsV1) string {
+		workspace, _, err := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("ws-1"))
+		Expect(err).To(BeNil())
+		return *workspace.Name
+	}
+
+	It(`Serves responses without validators for the TTL`, func() {
+		cache := schematicsService.EnableResponseCache(&schematicsv1.ResponseCacheOptions{TTL: 100 * time.Millisecond})
+		Expect(getWorkspaceName(schematicsService)).To(Equal("response-1"))
+		Expect(getWorkspaceName(schematicsService)).To(Equal("response-1"))
+		Expect(requests).To(HaveLen(1))
+		Expect(cache.Stats()).To(Equal(schematicsv1.CacheStats{Hits: 1, Misses: 1, Tracked: 1}))
+
+		time.Sleep(150 * time.Millisecond)
+		Expect(getWorkspaceName(schematicsService)).To(Equal("response-2"))
+		Expect(cache.Stats().Misses).To(Equal(uint64(2)))
+	})
+
+	It(`Revalidates responses with an ETag`, func() {
+		etag = `"v1"`
+		cache := schematicsService.EnableResponseCache(nil)
+		Expect(getWorkspaceName(schematicsService)).To(Equal("response-1"))
+		Expect(getWorkspaceName(schematicsService)).To(Equal("response-1"))
+		Expect(requests).To(HaveLen(2))
+		Expect(requests[0].Header.Get("If-None-Match")).To(BeEmpty())
+		Expect(requests[1].Header.Get("If-None-Match")).To(Equal(`"v1"`))
+		Expect(cache.Stats()).To(Equal(schematicsv1.CacheStats{Hits: 1, Misses: 1, Revalidations: 1, Tracked: 1}))
+
+		etag = `"v2"`
+		Expect(getWorkspaceName(schematicsService)).To(Equal("response-3"))
+		Expect(cache.Stats().Misses).To(Equal(uint64(2)))
+	})
+
+	It(`Invalidates the responses of a resource changed by a request`, func() {
+		cache := schematicsService.EnableResponseCache(nil)
+		Expect(getWorkspaceName(schematicsService)).To(Equal("response-1"))
+
+		_, _, err := schematicsService.ApplyWorkspaceCommand(schematicsService.NewApplyWorkspaceCommandOptions("ws-1", "refresh-token"))
+		Expect(err).To(BeNil())
+		Expect(getWorkspaceName(schematicsService)).To(Equal("response-3"))
+		Expect(cache.Stats()).To(Equal(schematicsv1.CacheStats{Misses: 2, Invalidations: 1, Tracked: 1}))
+
+		cache.Invalidate("ws-1")
+		Expect(getWorkspaceName(schematicsService)).To(Equal("response-4"))
+		Expect(cache.Stats().Invalidations).To(Equal(uint64(2)))
+	})
+
+	It(`Caches only the selected operations and cacheable responses`, func() {
+		schematicsService.EnableResponseCache(&schematicsv1.ResponseCacheOptions{Operations: []string{"GetJob"}})
+		getWorkspaceName(schematicsService)
+		getWorkspaceName(schematicsService)
+		Expect(requests).To(HaveLen(2))
+
+		cacheControl = "no-store"
+		getJobOptions := schematicsService.NewGetJobOptions("job-1")
+		_, _, err := schematicsService.GetJob(getJobOptions)
+		Expect(err).To(BeNil())
+		_, _, err = schematicsService.GetJob(getJobOptions)
+		Expect(err).To(BeNil())
+		Expect(requests).To(HaveLen(4))
+	})
+
+	It(`Does not share responses between credentials`, func() {
+		cache := schematicsService.EnableResponseCache(nil)
+		other := schematicsService.Clone()
+		other.Service.Options.Authenticator = &core.BearerTokenAuthenticator{BearerToken: "token-2"}
+
+		Expect(getWorkspaceName(schematicsService)).To(Equal("response-1"))
+		Expect(getWorkspaceName(other)).To(Equal("response-2"))
+		Expect(getWorkspaceName(other)).To(Equal("response-2"))
+		Expect(cache.Stats()).To(Equal(schematicsv1.CacheStats{Hits: 1, Misses: 2, Tracked: 1}))
+	})
+
+	It(`Invalidates the responses of a resource named by a request body`, func() {
+		schematicsService.EnableResponseCache(nil)
+		Expect(getWorkspaceName(schematicsService)).To(Equal("response-1"))
+
+		createJobOptions := schematicsService.NewCreateJobOptions("refresh-token")
+		createJobOptions.SetCommandObject("workspace").SetCommandObjectID("ws-1").SetCommandName("workspace_plan")
+		_, _, err := schematicsService.CreateJob(createJobOptions)
+		Expect(err).To(BeNil())
+		Expect(getWorkspaceName(schematicsService)).To(Equal("response-3"))
+	})
+
+	It(`Invalidates the list responses of a collection changed by a request`, func() {
+		schematicsService.EnableResponseCache(&schematicsv1.ResponseCacheOptions{Operations: []string{"ListWorkspaces", "GetWorkspace"}})
+		listWorkspaces := func() {
+			_, _, err := schematicsService.ListWorkspaces(schematicsService.NewListWorkspacesOptions())
+			Expect(err).To(BeNil())
+		}
+		listWorkspaces()
+		listWorkspaces()
+		Expect(requests).To(HaveLen(1))
+		Expect(getWorkspaceName(schematicsService)).To(Equal("response-2"))
+
+		_, _, err := schematicsService.UpdateWorkspace(schematicsService.NewUpdateWorkspaceOptions("ws-2"))
+		Expect(err).To(BeNil())
+		listWorkspaces()
+		Expect(requests).To(HaveLen(4))
+		// The responses of the other workspaces are kept.
+		Expect(getWorkspaceName(schematicsService)).To(Equal("response-2"))
+	})
+
+	It(`Forgets the resources of evicted responses`, func() {
+		cache := schematicsService.EnableResponseCache(&schematicsv1.ResponseCacheOptions{Store: schematicsv1.NewLRUCacheStore(1)})
+		getWorkspaceName(schematicsService)
+		Expect(cache.Stats().Tracked).To(Equal(1))
+
+		_, _, err := schematicsService.GetJob(schematicsService.NewGetJobOptions("job-1"))
+		Expect(err).To(BeNil())
+		for i := 0; i < 5; i++ {
+			_, _, err = schematicsService.UpdateWorkspace(schematicsService.NewUpdateWorkspaceOptions(fmt.Sprintf("ws-%d", i+2)))
+			Expect(err).To(BeNil())
+		}
+		Expect(cache.Stats().Tracked).To(Equal(1))
+
+		// The evicted response of the workspace is fetched again.
+		Expect(getWorkspaceName(schematicsService)).To(Equal("response-8"))
+	})
+})
+
+var _ = Describe(`LRUCacheStore`, func() {
+	It(`Evicts the least recently used responses`, func() {
+		store := schematicsv1.NewLRUCacheStore(2)
+		var removed []string
+		store.OnRemove(func(key string, response *schematicsv1.CachedResponse) {
+			removed = append(removed, key)
+		})
+		Expect(store.Put("a", &schematicsv1.CachedResponse{StatusCode: 200})).To(Succeed())
+		Expect(store.Put("b", &schematicsv1.CachedResponse{StatusCode: 200})).To(Succeed())
+		response, err := store.Get("a")
+		Expect(err).To(BeNil())
+		Expect(response).ToNot(BeNil())
+
+		Expect(store.Put("c", &schematicsv1.CachedResponse{StatusCode: 200})).To(Succeed())
+		Expect(store.Len()).To(Equal(2))
+		response, _ = store.Get("b")
+		Expect(response).To(BeNil())
+		response, _ = store.Get("a")
+		Expect(response).ToNot(BeNil())
+
+		Expect(store.Put("c", &schematicsv1.CachedResponse{StatusCode: 304})).To(Succeed())
+		Expect(store.Delete("a")).To(Succeed())
+		response, _ = store.Get("a")
+		Expect(response).To(BeNil())
+		Expect(store.Len()).To(Equal(1))
+		Expect(removed).To(Equal([]string{"b", "c", "a"}))
+	})
+})
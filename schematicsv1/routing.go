@@ -0,0 +1,856 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// RoutingSchematicsV1 wraps a SchematicsV1 instance and sends each operation that targets an existing
+// workspace, action, job, blueprint or agent to the regional endpoint that owns it.
+//
+// Schematics IDs carry the owning location as a prefix (e.g. "us-south.workspace.foo.abc123").
+// Operations whose ID has a recognized region prefix are invoked on a per-region clone of the wrapped
+// instance; all other operations, and IDs without a prefix, use the wrapped instance as is.
+type RoutingSchematicsV1 struct {
+	*SchematicsV1
+
+	// private is true if the regional clones should use the private service endpoints.
+	private bool
+
+	mutex           sync.Mutex
+	regionalClients map[string]*SchematicsV1
+}
+
+// NewRoutingSchematicsV1 constructs a RoutingSchematicsV1 that uses "schematics" for requests that are not
+// routed to a specific region. If the service URL of "schematics" is a private endpoint, regional
+// requests are routed to the private endpoints as well.
+func NewRoutingSchematicsV1(schematics *SchematicsV1) *RoutingSchematicsV1 {
+	router := &RoutingSchematicsV1{
+		SchematicsV1:    schematics,
+		regionalClients: make(map[string]*SchematicsV1),
+	}
+	if serviceURL, err := url.Parse(schematics.GetServiceURL()); err == nil {
+		router.private = strings.HasPrefix(serviceURL.Hostname(), "private")
+	}
+	return router
+}
+
+// RegionFromID returns the region encoded in the location prefix of a Schematics ID,
+// or an empty string if the ID does not start with a known region.
+func RegionFromID(id string) string {
+	prefix := strings.SplitN(id, ".", 2)[0]
+	if _, ok := regionalEndpoints[prefix]; ok && prefix != id {
+		return prefix
+	}
+	return ""
+}
+
+// ClientForRegion returns the instance used to send requests to the specified region.
+// Regional instances are cloned from the wrapped instance on first use and then reused.
+func (router *RoutingSchematicsV1) ClientForRegion(region string) (*SchematicsV1, error) {
+	router.mutex.Lock()
+	defer router.mutex.Unlock()
+
+	if client, ok := router.regionalClients[region]; ok {
+		return client, nil
+	}
+
+	var regionURL string
+	var err error
+	if router.private {
+		regionURL, err = GetPrivateServiceURLForRegion(region)
+	} else {
+		regionURL, err = GetServiceURLForRegion(region)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	client := router.SchematicsV1
+	if regionURL != router.GetServiceURL() {
+		client = router.SchematicsV1.Clone()
+		err = client.SetServiceURL(regionURL)
+		if err != nil {
+			return nil, fmt.Errorf("unable to set service URL for region '%s': %s", region, err.Error())
+		}
+	}
+	router.regionalClients[region] = client
+	return client, nil
+}
+
+// ClientForID returns the instance used to send requests for the resource identified by "id".
+// The wrapped instance is returned if "id" has no recognized region prefix.
+func (router *RoutingSchematicsV1) ClientForID(id string) *SchematicsV1 {
+	region := RegionFromID(id)
+	if region == "" {
+		return router.SchematicsV1
+	}
+	client, err := router.ClientForRegion(region)
+	if err != nil {
+		return router.SchematicsV1
+	}
+	return client
+}
+
+// DeleteWorkspace invokes SchematicsV1.DeleteWorkspace against the region that owns deleteWorkspaceOptions.WID.
+func (router *RoutingSchematicsV1) DeleteWorkspace(deleteWorkspaceOptions *DeleteWorkspaceOptions) (result *string, response *core.DetailedResponse, err error) {
+	return router.DeleteWorkspaceWithContext(context.Background(), deleteWorkspaceOptions)
+}
+
+// DeleteWorkspaceWithContext is an alternate form of the DeleteWorkspace method which supports a Context parameter
+func (router *RoutingSchematicsV1) DeleteWorkspaceWithContext(ctx context.Context, deleteWorkspaceOptions *DeleteWorkspaceOptions) (result *string, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if deleteWorkspaceOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(deleteWorkspaceOptions.WID))
+	}
+	return schematics.DeleteWorkspaceWithContext(ctx, deleteWorkspaceOptions)
+}
+
+// GetAllWorkspaceInputs invokes SchematicsV1.GetAllWorkspaceInputs against the region that owns getAllWorkspaceInputsOptions.WID.
+func (router *RoutingSchematicsV1) GetAllWorkspaceInputs(getAllWorkspaceInputsOptions *GetAllWorkspaceInputsOptions) (result *WorkspaceTemplateValuesResponse, response *core.DetailedResponse, err error) {
+	return router.GetAllWorkspaceInputsWithContext(context.Background(), getAllWorkspaceInputsOptions)
+}
+
+// GetAllWorkspaceInputsWithContext is an alternate form of the GetAllWorkspaceInputs method which supports a Context parameter
+func (router *RoutingSchematicsV1) GetAllWorkspaceInputsWithContext(ctx context.Context, getAllWorkspaceInputsOptions *GetAllWorkspaceInputsOptions) (result *WorkspaceTemplateValuesResponse, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if getAllWorkspaceInputsOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(getAllWorkspaceInputsOptions.WID))
+	}
+	return schematics.GetAllWorkspaceInputsWithContext(ctx, getAllWorkspaceInputsOptions)
+}
+
+// GetTemplateActivityLog invokes SchematicsV1.GetTemplateActivityLog against the region that owns getTemplateActivityLogOptions.WID.
+func (router *RoutingSchematicsV1) GetTemplateActivityLog(getTemplateActivityLogOptions *GetTemplateActivityLogOptions) (result *string, response *core.DetailedResponse, err error) {
+	return router.GetTemplateActivityLogWithContext(context.Background(), getTemplateActivityLogOptions)
+}
+
+// GetTemplateActivityLogWithContext is an alternate form of the GetTemplateActivityLog method which supports a Context parameter
+func (router *RoutingSchematicsV1) GetTemplateActivityLogWithContext(ctx context.Context, getTemplateActivityLogOptions *GetTemplateActivityLogOptions) (result *string, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if getTemplateActivityLogOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(getTemplateActivityLogOptions.WID))
+	}
+	return schematics.GetTemplateActivityLogWithContext(ctx, getTemplateActivityLogOptions)
+}
+
+// GetTemplateLogs invokes SchematicsV1.GetTemplateLogs against the region that owns getTemplateLogsOptions.WID.
+func (router *RoutingSchematicsV1) GetTemplateLogs(getTemplateLogsOptions *GetTemplateLogsOptions) (result *string, response *core.DetailedResponse, err error) {
+	return router.GetTemplateLogsWithContext(context.Background(), getTemplateLogsOptions)
+}
+
+// GetTemplateLogsWithContext is an alternate form of the GetTemplateLogs method which supports a Context parameter
+func (router *RoutingSchematicsV1) GetTemplateLogsWithContext(ctx context.Context, getTemplateLogsOptions *GetTemplateLogsOptions) (result *string, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if getTemplateLogsOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(getTemplateLogsOptions.WID))
+	}
+	return schematics.GetTemplateLogsWithContext(ctx, getTemplateLogsOptions)
+}
+
+// GetWorkspace invokes SchematicsV1.GetWorkspace against the region that owns getWorkspaceOptions.WID.
+func (router *RoutingSchematicsV1) GetWorkspace(getWorkspaceOptions *GetWorkspaceOptions) (result *WorkspaceResponse, response *core.DetailedResponse, err error) {
+	return router.GetWorkspaceWithContext(context.Background(), getWorkspaceOptions)
+}
+
+// GetWorkspaceWithContext is an alternate form of the GetWorkspace method which supports a Context parameter
+func (router *RoutingSchematicsV1) GetWorkspaceWithContext(ctx context.Context, getWorkspaceOptions *GetWorkspaceOptions) (result *WorkspaceResponse, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if getWorkspaceOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(getWorkspaceOptions.WID))
+	}
+	return schematics.GetWorkspaceWithContext(ctx, getWorkspaceOptions)
+}
+
+// GetWorkspaceActivityLogs invokes SchematicsV1.GetWorkspaceActivityLogs against the region that owns getWorkspaceActivityLogsOptions.WID.
+func (router *RoutingSchematicsV1) GetWorkspaceActivityLogs(getWorkspaceActivityLogsOptions *GetWorkspaceActivityLogsOptions) (result *WorkspaceActivityLogs, response *core.DetailedResponse, err error) {
+	return router.GetWorkspaceActivityLogsWithContext(context.Background(), getWorkspaceActivityLogsOptions)
+}
+
+// GetWorkspaceActivityLogsWithContext is an alternate form of the GetWorkspaceActivityLogs method which supports a Context parameter
+func (router *RoutingSchematicsV1) GetWorkspaceActivityLogsWithContext(ctx context.Context, getWorkspaceActivityLogsOptions *GetWorkspaceActivityLogsOptions) (result *WorkspaceActivityLogs, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if getWorkspaceActivityLogsOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(getWorkspaceActivityLogsOptions.WID))
+	}
+	return schematics.GetWorkspaceActivityLogsWithContext(ctx, getWorkspaceActivityLogsOptions)
+}
+
+// GetWorkspaceInputMetadata invokes SchematicsV1.GetWorkspaceInputMetadata against the region that owns getWorkspaceInputMetadataOptions.WID.
+func (router *RoutingSchematicsV1) GetWorkspaceInputMetadata(getWorkspaceInputMetadataOptions *GetWorkspaceInputMetadataOptions) (result []map[string]interface{}, response *core.DetailedResponse, err error) {
+	return router.GetWorkspaceInputMetadataWithContext(context.Background(), getWorkspaceInputMetadataOptions)
+}
+
+// GetWorkspaceInputMetadataWithContext is an alternate form of the GetWorkspaceInputMetadata method which supports a Context parameter
+func (router *RoutingSchematicsV1) GetWorkspaceInputMetadataWithContext(ctx context.Context, getWorkspaceInputMetadataOptions *GetWorkspaceInputMetadataOptions) (result []map[string]interface{}, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if getWorkspaceInputMetadataOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(getWorkspaceInputMetadataOptions.WID))
+	}
+	return schematics.GetWorkspaceInputMetadataWithContext(ctx, getWorkspaceInputMetadataOptions)
+}
+
+// GetWorkspaceInputs invokes SchematicsV1.GetWorkspaceInputs against the region that owns getWorkspaceInputsOptions.WID.
+func (router *RoutingSchematicsV1) GetWorkspaceInputs(getWorkspaceInputsOptions *GetWorkspaceInputsOptions) (result *TemplateValues, response *core.DetailedResponse, err error) {
+	return router.GetWorkspaceInputsWithContext(context.Background(), getWorkspaceInputsOptions)
+}
+
+// GetWorkspaceInputsWithContext is an alternate form of the GetWorkspaceInputs method which supports a Context parameter
+func (router *RoutingSchematicsV1) GetWorkspaceInputsWithContext(ctx context.Context, getWorkspaceInputsOptions *GetWorkspaceInputsOptions) (result *TemplateValues, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if getWorkspaceInputsOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(getWorkspaceInputsOptions.WID))
+	}
+	return schematics.GetWorkspaceInputsWithContext(ctx, getWorkspaceInputsOptions)
+}
+
+// GetWorkspaceLogUrls invokes SchematicsV1.GetWorkspaceLogUrls against the region that owns getWorkspaceLogUrlsOptions.WID.
+func (router *RoutingSchematicsV1) GetWorkspaceLogUrls(getWorkspaceLogUrlsOptions *GetWorkspaceLogUrlsOptions) (result *LogStoreResponseList, response *core.DetailedResponse, err error) {
+	return router.GetWorkspaceLogUrlsWithContext(context.Background(), getWorkspaceLogUrlsOptions)
+}
+
+// GetWorkspaceLogUrlsWithContext is an alternate form of the GetWorkspaceLogUrls method which supports a Context parameter
+func (router *RoutingSchematicsV1) GetWorkspaceLogUrlsWithContext(ctx context.Context, getWorkspaceLogUrlsOptions *GetWorkspaceLogUrlsOptions) (result *LogStoreResponseList, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if getWorkspaceLogUrlsOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(getWorkspaceLogUrlsOptions.WID))
+	}
+	return schematics.GetWorkspaceLogUrlsWithContext(ctx, getWorkspaceLogUrlsOptions)
+}
+
+// GetWorkspaceOutputs invokes SchematicsV1.GetWorkspaceOutputs against the region that owns getWorkspaceOutputsOptions.WID.
+func (router *RoutingSchematicsV1) GetWorkspaceOutputs(getWorkspaceOutputsOptions *GetWorkspaceOutputsOptions) (result []OutputValuesItem, response *core.DetailedResponse, err error) {
+	return router.GetWorkspaceOutputsWithContext(context.Background(), getWorkspaceOutputsOptions)
+}
+
+// GetWorkspaceOutputsWithContext is an alternate form of the GetWorkspaceOutputs method which supports a Context parameter
+func (router *RoutingSchematicsV1) GetWorkspaceOutputsWithContext(ctx context.Context, getWorkspaceOutputsOptions *GetWorkspaceOutputsOptions) (result []OutputValuesItem, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if getWorkspaceOutputsOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(getWorkspaceOutputsOptions.WID))
+	}
+	return schematics.GetWorkspaceOutputsWithContext(ctx, getWorkspaceOutputsOptions)
+}
+
+// GetWorkspaceReadme invokes SchematicsV1.GetWorkspaceReadme against the region that owns getWorkspaceReadmeOptions.WID.
+func (router *RoutingSchematicsV1) GetWorkspaceReadme(getWorkspaceReadmeOptions *GetWorkspaceReadmeOptions) (result *TemplateReadme, response *core.DetailedResponse, err error) {
+	return router.GetWorkspaceReadmeWithContext(context.Background(), getWorkspaceReadmeOptions)
+}
+
+// GetWorkspaceReadmeWithContext is an alternate form of the GetWorkspaceReadme method which supports a Context parameter
+func (router *RoutingSchematicsV1) GetWorkspaceReadmeWithContext(ctx context.Context, getWorkspaceReadmeOptions *GetWorkspaceReadmeOptions) (result *TemplateReadme, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if getWorkspaceReadmeOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(getWorkspaceReadmeOptions.WID))
+	}
+	return schematics.GetWorkspaceReadmeWithContext(ctx, getWorkspaceReadmeOptions)
+}
+
+// GetWorkspaceResources invokes SchematicsV1.GetWorkspaceResources against the region that owns getWorkspaceResourcesOptions.WID.
+func (router *RoutingSchematicsV1) GetWorkspaceResources(getWorkspaceResourcesOptions *GetWorkspaceResourcesOptions) (result []TemplateResources, response *core.DetailedResponse, err error) {
+	return router.GetWorkspaceResourcesWithContext(context.Background(), getWorkspaceResourcesOptions)
+}
+
+// GetWorkspaceResourcesWithContext is an alternate form of the GetWorkspaceResources method which supports a Context parameter
+func (router *RoutingSchematicsV1) GetWorkspaceResourcesWithContext(ctx context.Context, getWorkspaceResourcesOptions *GetWorkspaceResourcesOptions) (result []TemplateResources, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if getWorkspaceResourcesOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(getWorkspaceResourcesOptions.WID))
+	}
+	return schematics.GetWorkspaceResourcesWithContext(ctx, getWorkspaceResourcesOptions)
+}
+
+// GetWorkspaceState invokes SchematicsV1.GetWorkspaceState against the region that owns getWorkspaceStateOptions.WID.
+func (router *RoutingSchematicsV1) GetWorkspaceState(getWorkspaceStateOptions *GetWorkspaceStateOptions) (result *StateStoreResponseList, response *core.DetailedResponse, err error) {
+	return router.GetWorkspaceStateWithContext(context.Background(), getWorkspaceStateOptions)
+}
+
+// GetWorkspaceStateWithContext is an alternate form of the GetWorkspaceState method which supports a Context parameter
+func (router *RoutingSchematicsV1) GetWorkspaceStateWithContext(ctx context.Context, getWorkspaceStateOptions *GetWorkspaceStateOptions) (result *StateStoreResponseList, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if getWorkspaceStateOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(getWorkspaceStateOptions.WID))
+	}
+	return schematics.GetWorkspaceStateWithContext(ctx, getWorkspaceStateOptions)
+}
+
+// GetWorkspaceTemplateState invokes SchematicsV1.GetWorkspaceTemplateState against the region that owns getWorkspaceTemplateStateOptions.WID.
+func (router *RoutingSchematicsV1) GetWorkspaceTemplateState(getWorkspaceTemplateStateOptions *GetWorkspaceTemplateStateOptions) (result *TemplateStateStore, response *core.DetailedResponse, err error) {
+	return router.GetWorkspaceTemplateStateWithContext(context.Background(), getWorkspaceTemplateStateOptions)
+}
+
+// GetWorkspaceTemplateStateWithContext is an alternate form of the GetWorkspaceTemplateState method which supports a Context parameter
+func (router *RoutingSchematicsV1) GetWorkspaceTemplateStateWithContext(ctx context.Context, getWorkspaceTemplateStateOptions *GetWorkspaceTemplateStateOptions) (result *TemplateStateStore, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if getWorkspaceTemplateStateOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(getWorkspaceTemplateStateOptions.WID))
+	}
+	return schematics.GetWorkspaceTemplateStateWithContext(ctx, getWorkspaceTemplateStateOptions)
+}
+
+// ReplaceWorkspace invokes SchematicsV1.ReplaceWorkspace against the region that owns replaceWorkspaceOptions.WID.
+func (router *RoutingSchematicsV1) ReplaceWorkspace(replaceWorkspaceOptions *ReplaceWorkspaceOptions) (result *WorkspaceResponse, response *core.DetailedResponse, err error) {
+	return router.ReplaceWorkspaceWithContext(context.Background(), replaceWorkspaceOptions)
+}
+
+// ReplaceWorkspaceWithContext is an alternate form of the ReplaceWorkspace method which supports a Context parameter
+func (router *RoutingSchematicsV1) ReplaceWorkspaceWithContext(ctx context.Context, replaceWorkspaceOptions *ReplaceWorkspaceOptions) (result *WorkspaceResponse, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if replaceWorkspaceOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(replaceWorkspaceOptions.WID))
+	}
+	return schematics.ReplaceWorkspaceWithContext(ctx, replaceWorkspaceOptions)
+}
+
+// ReplaceWorkspaceInputs invokes SchematicsV1.ReplaceWorkspaceInputs against the region that owns replaceWorkspaceInputsOptions.WID.
+func (router *RoutingSchematicsV1) ReplaceWorkspaceInputs(replaceWorkspaceInputsOptions *ReplaceWorkspaceInputsOptions) (result *UserValues, response *core.DetailedResponse, err error) {
+	return router.ReplaceWorkspaceInputsWithContext(context.Background(), replaceWorkspaceInputsOptions)
+}
+
+// ReplaceWorkspaceInputsWithContext is an alternate form of the ReplaceWorkspaceInputs method which supports a Context parameter
+func (router *RoutingSchematicsV1) ReplaceWorkspaceInputsWithContext(ctx context.Context, replaceWorkspaceInputsOptions *ReplaceWorkspaceInputsOptions) (result *UserValues, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if replaceWorkspaceInputsOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(replaceWorkspaceInputsOptions.WID))
+	}
+	return schematics.ReplaceWorkspaceInputsWithContext(ctx, replaceWorkspaceInputsOptions)
+}
+
+// TemplateRepoUpload invokes SchematicsV1.TemplateRepoUpload against the region that owns templateRepoUploadOptions.WID.
+func (router *RoutingSchematicsV1) TemplateRepoUpload(templateRepoUploadOptions *TemplateRepoUploadOptions) (result *TemplateRepoTarUploadResponse, response *core.DetailedResponse, err error) {
+	return router.TemplateRepoUploadWithContext(context.Background(), templateRepoUploadOptions)
+}
+
+// TemplateRepoUploadWithContext is an alternate form of the TemplateRepoUpload method which supports a Context parameter
+func (router *RoutingSchematicsV1) TemplateRepoUploadWithContext(ctx context.Context, templateRepoUploadOptions *TemplateRepoUploadOptions) (result *TemplateRepoTarUploadResponse, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if templateRepoUploadOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(templateRepoUploadOptions.WID))
+	}
+	return schematics.TemplateRepoUploadWithContext(ctx, templateRepoUploadOptions)
+}
+
+// UpdateWorkspace invokes SchematicsV1.UpdateWorkspace against the region that owns updateWorkspaceOptions.WID.
+func (router *RoutingSchematicsV1) UpdateWorkspace(updateWorkspaceOptions *UpdateWorkspaceOptions) (result *WorkspaceResponse, response *core.DetailedResponse, err error) {
+	return router.UpdateWorkspaceWithContext(context.Background(), updateWorkspaceOptions)
+}
+
+// UpdateWorkspaceWithContext is an alternate form of the UpdateWorkspace method which supports a Context parameter
+func (router *RoutingSchematicsV1) UpdateWorkspaceWithContext(ctx context.Context, updateWorkspaceOptions *UpdateWorkspaceOptions) (result *WorkspaceResponse, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if updateWorkspaceOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(updateWorkspaceOptions.WID))
+	}
+	return schematics.UpdateWorkspaceWithContext(ctx, updateWorkspaceOptions)
+}
+
+// DeleteAction invokes SchematicsV1.DeleteAction against the region that owns deleteActionOptions.ActionID.
+func (router *RoutingSchematicsV1) DeleteAction(deleteActionOptions *DeleteActionOptions) (response *core.DetailedResponse, err error) {
+	return router.DeleteActionWithContext(context.Background(), deleteActionOptions)
+}
+
+// DeleteActionWithContext is an alternate form of the DeleteAction method which supports a Context parameter
+func (router *RoutingSchematicsV1) DeleteActionWithContext(ctx context.Context, deleteActionOptions *DeleteActionOptions) (response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if deleteActionOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(deleteActionOptions.ActionID))
+	}
+	return schematics.DeleteActionWithContext(ctx, deleteActionOptions)
+}
+
+// GetAction invokes SchematicsV1.GetAction against the region that owns getActionOptions.ActionID.
+func (router *RoutingSchematicsV1) GetAction(getActionOptions *GetActionOptions) (result *Action, response *core.DetailedResponse, err error) {
+	return router.GetActionWithContext(context.Background(), getActionOptions)
+}
+
+// GetActionWithContext is an alternate form of the GetAction method which supports a Context parameter
+func (router *RoutingSchematicsV1) GetActionWithContext(ctx context.Context, getActionOptions *GetActionOptions) (result *Action, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if getActionOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(getActionOptions.ActionID))
+	}
+	return schematics.GetActionWithContext(ctx, getActionOptions)
+}
+
+// UpdateAction invokes SchematicsV1.UpdateAction against the region that owns updateActionOptions.ActionID.
+func (router *RoutingSchematicsV1) UpdateAction(updateActionOptions *UpdateActionOptions) (result *Action, response *core.DetailedResponse, err error) {
+	return router.UpdateActionWithContext(context.Background(), updateActionOptions)
+}
+
+// UpdateActionWithContext is an alternate form of the UpdateAction method which supports a Context parameter
+func (router *RoutingSchematicsV1) UpdateActionWithContext(ctx context.Context, updateActionOptions *UpdateActionOptions) (result *Action, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if updateActionOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(updateActionOptions.ActionID))
+	}
+	return schematics.UpdateActionWithContext(ctx, updateActionOptions)
+}
+
+// UploadTemplateTarAction invokes SchematicsV1.UploadTemplateTarAction against the region that owns uploadTemplateTarActionOptions.ActionID.
+func (router *RoutingSchematicsV1) UploadTemplateTarAction(uploadTemplateTarActionOptions *UploadTemplateTarActionOptions) (result *TemplateRepoTarUploadResponse, response *core.DetailedResponse, err error) {
+	return router.UploadTemplateTarActionWithContext(context.Background(), uploadTemplateTarActionOptions)
+}
+
+// UploadTemplateTarActionWithContext is an alternate form of the UploadTemplateTarAction method which supports a Context parameter
+func (router *RoutingSchematicsV1) UploadTemplateTarActionWithContext(ctx context.Context, uploadTemplateTarActionOptions *UploadTemplateTarActionOptions) (result *TemplateRepoTarUploadResponse, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if uploadTemplateTarActionOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(uploadTemplateTarActionOptions.ActionID))
+	}
+	return schematics.UploadTemplateTarActionWithContext(ctx, uploadTemplateTarActionOptions)
+}
+
+// ApplyWorkspaceCommand invokes SchematicsV1.ApplyWorkspaceCommand against the region that owns applyWorkspaceCommandOptions.WID.
+func (router *RoutingSchematicsV1) ApplyWorkspaceCommand(applyWorkspaceCommandOptions *ApplyWorkspaceCommandOptions) (result *WorkspaceActivityApplyResult, response *core.DetailedResponse, err error) {
+	return router.ApplyWorkspaceCommandWithContext(context.Background(), applyWorkspaceCommandOptions)
+}
+
+// ApplyWorkspaceCommandWithContext is an alternate form of the ApplyWorkspaceCommand method which supports a Context parameter
+func (router *RoutingSchematicsV1) ApplyWorkspaceCommandWithContext(ctx context.Context, applyWorkspaceCommandOptions *ApplyWorkspaceCommandOptions) (result *WorkspaceActivityApplyResult, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if applyWorkspaceCommandOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(applyWorkspaceCommandOptions.WID))
+	}
+	return schematics.ApplyWorkspaceCommandWithContext(ctx, applyWorkspaceCommandOptions)
+}
+
+// CreateJob invokes SchematicsV1.CreateJob against the region that owns createJobOptions.CommandObjectID.
+func (router *RoutingSchematicsV1) CreateJob(createJobOptions *CreateJobOptions) (result *Job, response *core.DetailedResponse, err error) {
+	return router.CreateJobWithContext(context.Background(), createJobOptions)
+}
+
+// CreateJobWithContext is an alternate form of the CreateJob method which supports a Context parameter
+func (router *RoutingSchematicsV1) CreateJobWithContext(ctx context.Context, createJobOptions *CreateJobOptions) (result *Job, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if createJobOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(createJobOptions.CommandObjectID))
+	}
+	return schematics.CreateJobWithContext(ctx, createJobOptions)
+}
+
+// DeleteJob invokes SchematicsV1.DeleteJob against the region that owns deleteJobOptions.JobID.
+func (router *RoutingSchematicsV1) DeleteJob(deleteJobOptions *DeleteJobOptions) (response *core.DetailedResponse, err error) {
+	return router.DeleteJobWithContext(context.Background(), deleteJobOptions)
+}
+
+// DeleteJobWithContext is an alternate form of the DeleteJob method which supports a Context parameter
+func (router *RoutingSchematicsV1) DeleteJobWithContext(ctx context.Context, deleteJobOptions *DeleteJobOptions) (response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if deleteJobOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(deleteJobOptions.JobID))
+	}
+	return schematics.DeleteJobWithContext(ctx, deleteJobOptions)
+}
+
+// DeleteWorkspaceActivity invokes SchematicsV1.DeleteWorkspaceActivity against the region that owns deleteWorkspaceActivityOptions.WID.
+func (router *RoutingSchematicsV1) DeleteWorkspaceActivity(deleteWorkspaceActivityOptions *DeleteWorkspaceActivityOptions) (result *WorkspaceActivityApplyResult, response *core.DetailedResponse, err error) {
+	return router.DeleteWorkspaceActivityWithContext(context.Background(), deleteWorkspaceActivityOptions)
+}
+
+// DeleteWorkspaceActivityWithContext is an alternate form of the DeleteWorkspaceActivity method which supports a Context parameter
+func (router *RoutingSchematicsV1) DeleteWorkspaceActivityWithContext(ctx context.Context, deleteWorkspaceActivityOptions *DeleteWorkspaceActivityOptions) (result *WorkspaceActivityApplyResult, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if deleteWorkspaceActivityOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(deleteWorkspaceActivityOptions.WID))
+	}
+	return schematics.DeleteWorkspaceActivityWithContext(ctx, deleteWorkspaceActivityOptions)
+}
+
+// DestroyWorkspaceCommand invokes SchematicsV1.DestroyWorkspaceCommand against the region that owns destroyWorkspaceCommandOptions.WID.
+func (router *RoutingSchematicsV1) DestroyWorkspaceCommand(destroyWorkspaceCommandOptions *DestroyWorkspaceCommandOptions) (result *WorkspaceActivityDestroyResult, response *core.DetailedResponse, err error) {
+	return router.DestroyWorkspaceCommandWithContext(context.Background(), destroyWorkspaceCommandOptions)
+}
+
+// DestroyWorkspaceCommandWithContext is an alternate form of the DestroyWorkspaceCommand method which supports a Context parameter
+func (router *RoutingSchematicsV1) DestroyWorkspaceCommandWithContext(ctx context.Context, destroyWorkspaceCommandOptions *DestroyWorkspaceCommandOptions) (result *WorkspaceActivityDestroyResult, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if destroyWorkspaceCommandOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(destroyWorkspaceCommandOptions.WID))
+	}
+	return schematics.DestroyWorkspaceCommandWithContext(ctx, destroyWorkspaceCommandOptions)
+}
+
+// GetJob invokes SchematicsV1.GetJob against the region that owns getJobOptions.JobID.
+func (router *RoutingSchematicsV1) GetJob(getJobOptions *GetJobOptions) (result *Job, response *core.DetailedResponse, err error) {
+	return router.GetJobWithContext(context.Background(), getJobOptions)
+}
+
+// GetJobWithContext is an alternate form of the GetJob method which supports a Context parameter
+func (router *RoutingSchematicsV1) GetJobWithContext(ctx context.Context, getJobOptions *GetJobOptions) (result *Job, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if getJobOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(getJobOptions.JobID))
+	}
+	return schematics.GetJobWithContext(ctx, getJobOptions)
+}
+
+// GetJobFiles invokes SchematicsV1.GetJobFiles against the region that owns getJobFilesOptions.JobID.
+func (router *RoutingSchematicsV1) GetJobFiles(getJobFilesOptions *GetJobFilesOptions) (result *JobFileData, response *core.DetailedResponse, err error) {
+	return router.GetJobFilesWithContext(context.Background(), getJobFilesOptions)
+}
+
+// GetJobFilesWithContext is an alternate form of the GetJobFiles method which supports a Context parameter
+func (router *RoutingSchematicsV1) GetJobFilesWithContext(ctx context.Context, getJobFilesOptions *GetJobFilesOptions) (result *JobFileData, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if getJobFilesOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(getJobFilesOptions.JobID))
+	}
+	return schematics.GetJobFilesWithContext(ctx, getJobFilesOptions)
+}
+
+// GetWorkspaceActivity invokes SchematicsV1.GetWorkspaceActivity against the region that owns getWorkspaceActivityOptions.WID.
+func (router *RoutingSchematicsV1) GetWorkspaceActivity(getWorkspaceActivityOptions *GetWorkspaceActivityOptions) (result *WorkspaceActivity, response *core.DetailedResponse, err error) {
+	return router.GetWorkspaceActivityWithContext(context.Background(), getWorkspaceActivityOptions)
+}
+
+// GetWorkspaceActivityWithContext is an alternate form of the GetWorkspaceActivity method which supports a Context parameter
+func (router *RoutingSchematicsV1) GetWorkspaceActivityWithContext(ctx context.Context, getWorkspaceActivityOptions *GetWorkspaceActivityOptions) (result *WorkspaceActivity, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if getWorkspaceActivityOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(getWorkspaceActivityOptions.WID))
+	}
+	return schematics.GetWorkspaceActivityWithContext(ctx, getWorkspaceActivityOptions)
+}
+
+// ListJobLogs invokes SchematicsV1.ListJobLogs against the region that owns listJobLogsOptions.JobID.
+func (router *RoutingSchematicsV1) ListJobLogs(listJobLogsOptions *ListJobLogsOptions) (result *JobLog, response *core.DetailedResponse, err error) {
+	return router.ListJobLogsWithContext(context.Background(), listJobLogsOptions)
+}
+
+// ListJobLogsWithContext is an alternate form of the ListJobLogs method which supports a Context parameter
+func (router *RoutingSchematicsV1) ListJobLogsWithContext(ctx context.Context, listJobLogsOptions *ListJobLogsOptions) (result *JobLog, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if listJobLogsOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(listJobLogsOptions.JobID))
+	}
+	return schematics.ListJobLogsWithContext(ctx, listJobLogsOptions)
+}
+
+// ListJobs invokes SchematicsV1.ListJobs against the region that owns listJobsOptions.ActionID.
+func (router *RoutingSchematicsV1) ListJobs(listJobsOptions *ListJobsOptions) (result *JobList, response *core.DetailedResponse, err error) {
+	return router.ListJobsWithContext(context.Background(), listJobsOptions)
+}
+
+// ListJobsWithContext is an alternate form of the ListJobs method which supports a Context parameter
+func (router *RoutingSchematicsV1) ListJobsWithContext(ctx context.Context, listJobsOptions *ListJobsOptions) (result *JobList, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if listJobsOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(listJobsOptions.ActionID))
+	}
+	return schematics.ListJobsWithContext(ctx, listJobsOptions)
+}
+
+// ListWorkspaceActivities invokes SchematicsV1.ListWorkspaceActivities against the region that owns listWorkspaceActivitiesOptions.WID.
+func (router *RoutingSchematicsV1) ListWorkspaceActivities(listWorkspaceActivitiesOptions *ListWorkspaceActivitiesOptions) (result *WorkspaceActivities, response *core.DetailedResponse, err error) {
+	return router.ListWorkspaceActivitiesWithContext(context.Background(), listWorkspaceActivitiesOptions)
+}
+
+// ListWorkspaceActivitiesWithContext is an alternate form of the ListWorkspaceActivities method which supports a Context parameter
+func (router *RoutingSchematicsV1) ListWorkspaceActivitiesWithContext(ctx context.Context, listWorkspaceActivitiesOptions *ListWorkspaceActivitiesOptions) (result *WorkspaceActivities, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if listWorkspaceActivitiesOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(listWorkspaceActivitiesOptions.WID))
+	}
+	return schematics.ListWorkspaceActivitiesWithContext(ctx, listWorkspaceActivitiesOptions)
+}
+
+// PlanWorkspaceCommand invokes SchematicsV1.PlanWorkspaceCommand against the region that owns planWorkspaceCommandOptions.WID.
+func (router *RoutingSchematicsV1) PlanWorkspaceCommand(planWorkspaceCommandOptions *PlanWorkspaceCommandOptions) (result *WorkspaceActivityPlanResult, response *core.DetailedResponse, err error) {
+	return router.PlanWorkspaceCommandWithContext(context.Background(), planWorkspaceCommandOptions)
+}
+
+// PlanWorkspaceCommandWithContext is an alternate form of the PlanWorkspaceCommand method which supports a Context parameter
+func (router *RoutingSchematicsV1) PlanWorkspaceCommandWithContext(ctx context.Context, planWorkspaceCommandOptions *PlanWorkspaceCommandOptions) (result *WorkspaceActivityPlanResult, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if planWorkspaceCommandOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(planWorkspaceCommandOptions.WID))
+	}
+	return schematics.PlanWorkspaceCommandWithContext(ctx, planWorkspaceCommandOptions)
+}
+
+// RefreshWorkspaceCommand invokes SchematicsV1.RefreshWorkspaceCommand against the region that owns refreshWorkspaceCommandOptions.WID.
+func (router *RoutingSchematicsV1) RefreshWorkspaceCommand(refreshWorkspaceCommandOptions *RefreshWorkspaceCommandOptions) (result *WorkspaceActivityRefreshResult, response *core.DetailedResponse, err error) {
+	return router.RefreshWorkspaceCommandWithContext(context.Background(), refreshWorkspaceCommandOptions)
+}
+
+// RefreshWorkspaceCommandWithContext is an alternate form of the RefreshWorkspaceCommand method which supports a Context parameter
+func (router *RoutingSchematicsV1) RefreshWorkspaceCommandWithContext(ctx context.Context, refreshWorkspaceCommandOptions *RefreshWorkspaceCommandOptions) (result *WorkspaceActivityRefreshResult, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if refreshWorkspaceCommandOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(refreshWorkspaceCommandOptions.WID))
+	}
+	return schematics.RefreshWorkspaceCommandWithContext(ctx, refreshWorkspaceCommandOptions)
+}
+
+// RunWorkspaceCommands invokes SchematicsV1.RunWorkspaceCommands against the region that owns runWorkspaceCommandsOptions.WID.
+func (router *RoutingSchematicsV1) RunWorkspaceCommands(runWorkspaceCommandsOptions *RunWorkspaceCommandsOptions) (result *WorkspaceActivityCommandResult, response *core.DetailedResponse, err error) {
+	return router.RunWorkspaceCommandsWithContext(context.Background(), runWorkspaceCommandsOptions)
+}
+
+// RunWorkspaceCommandsWithContext is an alternate form of the RunWorkspaceCommands method which supports a Context parameter
+func (router *RoutingSchematicsV1) RunWorkspaceCommandsWithContext(ctx context.Context, runWorkspaceCommandsOptions *RunWorkspaceCommandsOptions) (result *WorkspaceActivityCommandResult, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if runWorkspaceCommandsOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(runWorkspaceCommandsOptions.WID))
+	}
+	return schematics.RunWorkspaceCommandsWithContext(ctx, runWorkspaceCommandsOptions)
+}
+
+// UpdateJob invokes SchematicsV1.UpdateJob against the region that owns updateJobOptions.JobID.
+func (router *RoutingSchematicsV1) UpdateJob(updateJobOptions *UpdateJobOptions) (result *Job, response *core.DetailedResponse, err error) {
+	return router.UpdateJobWithContext(context.Background(), updateJobOptions)
+}
+
+// UpdateJobWithContext is an alternate form of the UpdateJob method which supports a Context parameter
+func (router *RoutingSchematicsV1) UpdateJobWithContext(ctx context.Context, updateJobOptions *UpdateJobOptions) (result *Job, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if updateJobOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(updateJobOptions.JobID))
+	}
+	return schematics.UpdateJobWithContext(ctx, updateJobOptions)
+}
+
+// DeleteBlueprint invokes SchematicsV1.DeleteBlueprint against the region that owns deleteBlueprintOptions.BlueprintID.
+func (router *RoutingSchematicsV1) DeleteBlueprint(deleteBlueprintOptions *DeleteBlueprintOptions) (response *core.DetailedResponse, err error) {
+	return router.DeleteBlueprintWithContext(context.Background(), deleteBlueprintOptions)
+}
+
+// DeleteBlueprintWithContext is an alternate form of the DeleteBlueprint method which supports a Context parameter
+func (router *RoutingSchematicsV1) DeleteBlueprintWithContext(ctx context.Context, deleteBlueprintOptions *DeleteBlueprintOptions) (response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if deleteBlueprintOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(deleteBlueprintOptions.BlueprintID))
+	}
+	return schematics.DeleteBlueprintWithContext(ctx, deleteBlueprintOptions)
+}
+
+// GetBlueprint invokes SchematicsV1.GetBlueprint against the region that owns getBlueprintOptions.BlueprintID.
+func (router *RoutingSchematicsV1) GetBlueprint(getBlueprintOptions *GetBlueprintOptions) (result *Blueprint, response *core.DetailedResponse, err error) {
+	return router.GetBlueprintWithContext(context.Background(), getBlueprintOptions)
+}
+
+// GetBlueprintWithContext is an alternate form of the GetBlueprint method which supports a Context parameter
+func (router *RoutingSchematicsV1) GetBlueprintWithContext(ctx context.Context, getBlueprintOptions *GetBlueprintOptions) (result *Blueprint, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if getBlueprintOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(getBlueprintOptions.BlueprintID))
+	}
+	return schematics.GetBlueprintWithContext(ctx, getBlueprintOptions)
+}
+
+// ReplaceBlueprint invokes SchematicsV1.ReplaceBlueprint against the region that owns replaceBlueprintOptions.BlueprintID.
+func (router *RoutingSchematicsV1) ReplaceBlueprint(replaceBlueprintOptions *ReplaceBlueprintOptions) (result *Blueprint, response *core.DetailedResponse, err error) {
+	return router.ReplaceBlueprintWithContext(context.Background(), replaceBlueprintOptions)
+}
+
+// ReplaceBlueprintWithContext is an alternate form of the ReplaceBlueprint method which supports a Context parameter
+func (router *RoutingSchematicsV1) ReplaceBlueprintWithContext(ctx context.Context, replaceBlueprintOptions *ReplaceBlueprintOptions) (result *Blueprint, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if replaceBlueprintOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(replaceBlueprintOptions.BlueprintID))
+	}
+	return schematics.ReplaceBlueprintWithContext(ctx, replaceBlueprintOptions)
+}
+
+// UploadTemplateTarBlueprint invokes SchematicsV1.UploadTemplateTarBlueprint against the region that owns uploadTemplateTarBlueprintOptions.BlueprintID.
+func (router *RoutingSchematicsV1) UploadTemplateTarBlueprint(uploadTemplateTarBlueprintOptions *UploadTemplateTarBlueprintOptions) (result *BlueprintTemplateRepoTarUploadResponse, response *core.DetailedResponse, err error) {
+	return router.UploadTemplateTarBlueprintWithContext(context.Background(), uploadTemplateTarBlueprintOptions)
+}
+
+// UploadTemplateTarBlueprintWithContext is an alternate form of the UploadTemplateTarBlueprint method which supports a Context parameter
+func (router *RoutingSchematicsV1) UploadTemplateTarBlueprintWithContext(ctx context.Context, uploadTemplateTarBlueprintOptions *UploadTemplateTarBlueprintOptions) (result *BlueprintTemplateRepoTarUploadResponse, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if uploadTemplateTarBlueprintOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(uploadTemplateTarBlueprintOptions.BlueprintID))
+	}
+	return schematics.UploadTemplateTarBlueprintWithContext(ctx, uploadTemplateTarBlueprintOptions)
+}
+
+// DeleteAgent invokes SchematicsV1.DeleteAgent against the region that owns deleteAgentOptions.AgentID.
+func (router *RoutingSchematicsV1) DeleteAgent(deleteAgentOptions *DeleteAgentOptions) (response *core.DetailedResponse, err error) {
+	return router.DeleteAgentWithContext(context.Background(), deleteAgentOptions)
+}
+
+// DeleteAgentWithContext is an alternate form of the DeleteAgent method which supports a Context parameter
+func (router *RoutingSchematicsV1) DeleteAgentWithContext(ctx context.Context, deleteAgentOptions *DeleteAgentOptions) (response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if deleteAgentOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(deleteAgentOptions.AgentID))
+	}
+	return schematics.DeleteAgentWithContext(ctx, deleteAgentOptions)
+}
+
+// DeleteAgentData invokes SchematicsV1.DeleteAgentData against the region that owns deleteAgentDataOptions.AgentID.
+func (router *RoutingSchematicsV1) DeleteAgentData(deleteAgentDataOptions *DeleteAgentDataOptions) (response *core.DetailedResponse, err error) {
+	return router.DeleteAgentDataWithContext(context.Background(), deleteAgentDataOptions)
+}
+
+// DeleteAgentDataWithContext is an alternate form of the DeleteAgentData method which supports a Context parameter
+func (router *RoutingSchematicsV1) DeleteAgentDataWithContext(ctx context.Context, deleteAgentDataOptions *DeleteAgentDataOptions) (response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if deleteAgentDataOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(deleteAgentDataOptions.AgentID))
+	}
+	return schematics.DeleteAgentDataWithContext(ctx, deleteAgentDataOptions)
+}
+
+// DeployAgentJob invokes SchematicsV1.DeployAgentJob against the region that owns deployAgentJobOptions.AgentID.
+func (router *RoutingSchematicsV1) DeployAgentJob(deployAgentJobOptions *DeployAgentJobOptions) (result *AgentDeployJob, response *core.DetailedResponse, err error) {
+	return router.DeployAgentJobWithContext(context.Background(), deployAgentJobOptions)
+}
+
+// DeployAgentJobWithContext is an alternate form of the DeployAgentJob method which supports a Context parameter
+func (router *RoutingSchematicsV1) DeployAgentJobWithContext(ctx context.Context, deployAgentJobOptions *DeployAgentJobOptions) (result *AgentDeployJob, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if deployAgentJobOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(deployAgentJobOptions.AgentID))
+	}
+	return schematics.DeployAgentJobWithContext(ctx, deployAgentJobOptions)
+}
+
+// GetAgent invokes SchematicsV1.GetAgent against the region that owns getAgentOptions.AgentID.
+func (router *RoutingSchematicsV1) GetAgent(getAgentOptions *GetAgentOptions) (result *Agent, response *core.DetailedResponse, err error) {
+	return router.GetAgentWithContext(context.Background(), getAgentOptions)
+}
+
+// GetAgentWithContext is an alternate form of the GetAgent method which supports a Context parameter
+func (router *RoutingSchematicsV1) GetAgentWithContext(ctx context.Context, getAgentOptions *GetAgentOptions) (result *Agent, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if getAgentOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(getAgentOptions.AgentID))
+	}
+	return schematics.GetAgentWithContext(ctx, getAgentOptions)
+}
+
+// GetAgentData invokes SchematicsV1.GetAgentData against the region that owns getAgentDataOptions.AgentID.
+func (router *RoutingSchematicsV1) GetAgentData(getAgentDataOptions *GetAgentDataOptions) (result *AgentData, response *core.DetailedResponse, err error) {
+	return router.GetAgentDataWithContext(context.Background(), getAgentDataOptions)
+}
+
+// GetAgentDataWithContext is an alternate form of the GetAgentData method which supports a Context parameter
+func (router *RoutingSchematicsV1) GetAgentDataWithContext(ctx context.Context, getAgentDataOptions *GetAgentDataOptions) (result *AgentData, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if getAgentDataOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(getAgentDataOptions.AgentID))
+	}
+	return schematics.GetAgentDataWithContext(ctx, getAgentDataOptions)
+}
+
+// GetDeployAgentJob invokes SchematicsV1.GetDeployAgentJob against the region that owns getDeployAgentJobOptions.AgentID.
+func (router *RoutingSchematicsV1) GetDeployAgentJob(getDeployAgentJobOptions *GetDeployAgentJobOptions) (result *AgentDeployJob, response *core.DetailedResponse, err error) {
+	return router.GetDeployAgentJobWithContext(context.Background(), getDeployAgentJobOptions)
+}
+
+// GetDeployAgentJobWithContext is an alternate form of the GetDeployAgentJob method which supports a Context parameter
+func (router *RoutingSchematicsV1) GetDeployAgentJobWithContext(ctx context.Context, getDeployAgentJobOptions *GetDeployAgentJobOptions) (result *AgentDeployJob, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if getDeployAgentJobOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(getDeployAgentJobOptions.AgentID))
+	}
+	return schematics.GetDeployAgentJobWithContext(ctx, getDeployAgentJobOptions)
+}
+
+// GetHealthCheckAgentJob invokes SchematicsV1.GetHealthCheckAgentJob against the region that owns getHealthCheckAgentJobOptions.AgentID.
+func (router *RoutingSchematicsV1) GetHealthCheckAgentJob(getHealthCheckAgentJobOptions *GetHealthCheckAgentJobOptions) (result *AgentHealthJob, response *core.DetailedResponse, err error) {
+	return router.GetHealthCheckAgentJobWithContext(context.Background(), getHealthCheckAgentJobOptions)
+}
+
+// GetHealthCheckAgentJobWithContext is an alternate form of the GetHealthCheckAgentJob method which supports a Context parameter
+func (router *RoutingSchematicsV1) GetHealthCheckAgentJobWithContext(ctx context.Context, getHealthCheckAgentJobOptions *GetHealthCheckAgentJobOptions) (result *AgentHealthJob, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if getHealthCheckAgentJobOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(getHealthCheckAgentJobOptions.AgentID))
+	}
+	return schematics.GetHealthCheckAgentJobWithContext(ctx, getHealthCheckAgentJobOptions)
+}
+
+// GetPrsAgentJob invokes SchematicsV1.GetPrsAgentJob against the region that owns getPrsAgentJobOptions.AgentID.
+func (router *RoutingSchematicsV1) GetPrsAgentJob(getPrsAgentJobOptions *GetPrsAgentJobOptions) (result *AgentPRSJob, response *core.DetailedResponse, err error) {
+	return router.GetPrsAgentJobWithContext(context.Background(), getPrsAgentJobOptions)
+}
+
+// GetPrsAgentJobWithContext is an alternate form of the GetPrsAgentJob method which supports a Context parameter
+func (router *RoutingSchematicsV1) GetPrsAgentJobWithContext(ctx context.Context, getPrsAgentJobOptions *GetPrsAgentJobOptions) (result *AgentPRSJob, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if getPrsAgentJobOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(getPrsAgentJobOptions.AgentID))
+	}
+	return schematics.GetPrsAgentJobWithContext(ctx, getPrsAgentJobOptions)
+}
+
+// HealthCheckAgentJob invokes SchematicsV1.HealthCheckAgentJob against the region that owns healthCheckAgentJobOptions.AgentID.
+func (router *RoutingSchematicsV1) HealthCheckAgentJob(healthCheckAgentJobOptions *HealthCheckAgentJobOptions) (result *AgentHealthJob, response *core.DetailedResponse, err error) {
+	return router.HealthCheckAgentJobWithContext(context.Background(), healthCheckAgentJobOptions)
+}
+
+// HealthCheckAgentJobWithContext is an alternate form of the HealthCheckAgentJob method which supports a Context parameter
+func (router *RoutingSchematicsV1) HealthCheckAgentJobWithContext(ctx context.Context, healthCheckAgentJobOptions *HealthCheckAgentJobOptions) (result *AgentHealthJob, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if healthCheckAgentJobOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(healthCheckAgentJobOptions.AgentID))
+	}
+	return schematics.HealthCheckAgentJobWithContext(ctx, healthCheckAgentJobOptions)
+}
+
+// PrsAgentJob invokes SchematicsV1.PrsAgentJob against the region that owns prsAgentJobOptions.AgentID.
+func (router *RoutingSchematicsV1) PrsAgentJob(prsAgentJobOptions *PrsAgentJobOptions) (result *AgentPRSJob, response *core.DetailedResponse, err error) {
+	return router.PrsAgentJobWithContext(context.Background(), prsAgentJobOptions)
+}
+
+// PrsAgentJobWithContext is an alternate form of the PrsAgentJob method which supports a Context parameter
+func (router *RoutingSchematicsV1) PrsAgentJobWithContext(ctx context.Context, prsAgentJobOptions *PrsAgentJobOptions) (result *AgentPRSJob, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if prsAgentJobOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(prsAgentJobOptions.AgentID))
+	}
+	return schematics.PrsAgentJobWithContext(ctx, prsAgentJobOptions)
+}
+
+// UpdateAgentData invokes SchematicsV1.UpdateAgentData against the region that owns updateAgentDataOptions.AgentID.
+func (router *RoutingSchematicsV1) UpdateAgentData(updateAgentDataOptions *UpdateAgentDataOptions) (result *AgentData, response *core.DetailedResponse, err error) {
+	return router.UpdateAgentDataWithContext(context.Background(), updateAgentDataOptions)
+}
+
+// UpdateAgentDataWithContext is an alternate form of the UpdateAgentData method which supports a Context parameter
+func (router *RoutingSchematicsV1) UpdateAgentDataWithContext(ctx context.Context, updateAgentDataOptions *UpdateAgentDataOptions) (result *AgentData, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if updateAgentDataOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(updateAgentDataOptions.AgentID))
+	}
+	return schematics.UpdateAgentDataWithContext(ctx, updateAgentDataOptions)
+}
+
+// UpdateAgentRegistration invokes SchematicsV1.UpdateAgentRegistration against the region that owns updateAgentRegistrationOptions.AgentID.
+func (router *RoutingSchematicsV1) UpdateAgentRegistration(updateAgentRegistrationOptions *UpdateAgentRegistrationOptions) (result *Agent, response *core.DetailedResponse, err error) {
+	return router.UpdateAgentRegistrationWithContext(context.Background(), updateAgentRegistrationOptions)
+}
+
+// UpdateAgentRegistrationWithContext is an alternate form of the UpdateAgentRegistration method which supports a Context parameter
+func (router *RoutingSchematicsV1) UpdateAgentRegistrationWithContext(ctx context.Context, updateAgentRegistrationOptions *UpdateAgentRegistrationOptions) (result *Agent, response *core.DetailedResponse, err error) {
+	schematics := router.SchematicsV1
+	if updateAgentRegistrationOptions != nil {
+		schematics = router.ClientForID(core.StringNilMapper(updateAgentRegistrationOptions.AgentID))
+	}
+	return schematics.UpdateAgentRegistrationWithContext(ctx, updateAgentRegistrationOptions)
+}
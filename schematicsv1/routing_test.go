@@ -0,0 +1,133 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// roundTripperFunc adapts a function to the http.RoundTripper interface.
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// jsonResponse builds a canned JSON response for "req".
+func jsonResponse(req *http.Request, statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}
+}
+
+var _ = Describe(`RoutingSchematicsV1`, func() {
+	var schematicsService *schematicsv1.SchematicsV1
+	var router *schematicsv1.RoutingSchematicsV1
+	var requestedURLs []string
+
+	BeforeEach(func() {
+		var serviceErr error
+		schematicsService, serviceErr = schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		requestedURLs = nil
+		schematicsService.Service.SetHTTPClient(&http.Client{
+			Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				requestedURLs = append(requestedURLs, req.URL.String())
+				return jsonResponse(req, 200, `{"id": "ID"}`), nil
+			}),
+		})
+		router = schematicsv1.NewRoutingSchematicsV1(schematicsService)
+	})
+
+	It(`RegionFromID(id string)`, func() {
+		Expect(schematicsv1.RegionFromID("us-south.workspace.foo.abc123")).To(Equal("us-south"))
+		Expect(schematicsv1.RegionFromID("eu-de.ACTION.myAction.a1b2c3d4")).To(Equal("eu-de"))
+		Expect(schematicsv1.RegionFromID("myworkspace-abc123")).To(BeEmpty())
+		Expect(schematicsv1.RegionFromID("mars-north.workspace.foo.abc123")).To(BeEmpty())
+		Expect(schematicsv1.RegionFromID("us-south")).To(BeEmpty())
+		Expect(schematicsv1.RegionFromID("")).To(BeEmpty())
+	})
+	It(`ClientForRegion(region string)`, func() {
+		client, err := router.ClientForRegion("eu-gb")
+		Expect(err).To(BeNil())
+		Expect(client.GetServiceURL()).To(Equal("https://eu-gb.schematics.cloud.ibm.com"))
+		Expect(schematicsService.GetServiceURL()).To(Equal(schematicsv1.DefaultServiceURL))
+
+		again, err := router.ClientForRegion("eu-gb")
+		Expect(err).To(BeNil())
+		Expect(again).To(BeIdenticalTo(client))
+
+		client, err = router.ClientForRegion("INVALID_REGION")
+		Expect(err).ToNot(BeNil())
+		Expect(client).To(BeNil())
+	})
+	It(`ClientForRegion(region string) using private endpoints`, func() {
+		Expect(schematicsService.SetServiceURL("https://private-us-south.schematics.cloud.ibm.com")).To(BeNil())
+		router = schematicsv1.NewRoutingSchematicsV1(schematicsService)
+
+		client, err := router.ClientForRegion("us-south")
+		Expect(err).To(BeNil())
+		Expect(client).To(BeIdenticalTo(schematicsService))
+
+		client, err = router.ClientForRegion("ca-tor")
+		Expect(err).To(BeNil())
+		Expect(client.GetServiceURL()).To(Equal("https://private-ca-tor.schematics.cloud.ibm.com"))
+	})
+	It(`Invoke GetWorkspace with a regional workspace ID`, func() {
+		getWorkspaceOptionsModel := schematicsService.NewGetWorkspaceOptions("us-east.workspace.foo.abc123")
+		result, response, operationErr := router.GetWorkspace(getWorkspaceOptionsModel)
+		Expect(operationErr).To(BeNil())
+		Expect(response).ToNot(BeNil())
+		Expect(result).ToNot(BeNil())
+		Expect(requestedURLs).To(Equal([]string{"https://us-east.schematics.cloud.ibm.com/v1/workspaces/us-east.workspace.foo.abc123"}))
+	})
+	It(`Invoke GetJob with an ID that has no region prefix`, func() {
+		getJobOptionsModel := schematicsService.NewGetJobOptions("myjob-abc123")
+		_, _, operationErr := router.GetJob(getJobOptionsModel)
+		Expect(operationErr).To(BeNil())
+		Expect(requestedURLs).To(Equal([]string{"https://schematics.cloud.ibm.com/v2/jobs/myjob-abc123"}))
+	})
+	It(`Invoke CreateJob routed by the command object ID`, func() {
+		createJobOptionsModel := schematicsService.NewCreateJobOptions("testString")
+		createJobOptionsModel.SetCommandObject("workspace")
+		createJobOptionsModel.SetCommandObjectID("eu-de.workspace.foo.abc123")
+		createJobOptionsModel.SetCommandName("workspace_plan")
+		_, _, operationErr := router.CreateJob(createJobOptionsModel)
+		Expect(operationErr).To(BeNil())
+		Expect(requestedURLs).To(Equal([]string{"https://eu-de.schematics.cloud.ibm.com/v2/jobs"}))
+	})
+	It(`Invoke GetWorkspace with nil options`, func() {
+		result, response, operationErr := router.GetWorkspace(nil)
+		Expect(operationErr).ToNot(BeNil())
+		Expect(response).To(BeNil())
+		Expect(result).To(BeNil())
+		Expect(requestedURLs).To(BeEmpty())
+	})
+})
@@ -28,6 +28,7 @@ import (
 	"io"
 	"net/http"
 	"reflect"
+	"sort"
 	"time"
 
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -45,6 +46,15 @@ import (
 // API Version: 1.0
 type SchematicsV1 struct {
 	Service *core.BaseService
+
+	// The application identified in the User-Agent header, see SetApplicationInfo.
+	application *common.ApplicationInfo
+
+	// The provider of the refresh_token and delegated_token headers, see SetTokenProvider.
+	tokenProvider TokenProvider
+
+	// The providers of the Git tokens, by repository host, see SetGitTokenProvider.
+	gitTokenProviders gitTokenProviders
 }
 
 // DefaultServiceURL is the default URL to make service requests to.
@@ -58,6 +68,14 @@ type SchematicsV1Options struct {
 	ServiceName   string
 	URL           string
 	Authenticator core.Authenticator
+
+	// Region selects the public regional endpoint (e.g. "us-south") to send requests to.
+	// It is ignored when URL is also specified, or when the external configuration of
+	// NewSchematicsV1UsingExternalConfig specifies a URL.
+	Region string
+
+	// PrivateEndpoint selects the private endpoint of Region instead of its public endpoint.
+	PrivateEndpoint bool
 }
 
 // NewSchematicsV1UsingExternalConfig : constructs an instance of SchematicsV1 with passed in options and external configuration.
@@ -85,6 +103,19 @@ func NewSchematicsV1UsingExternalConfig(options *SchematicsV1Options) (schematic
 
 	if options.URL != "" {
 		err = schematics.Service.SetServiceURL(options.URL)
+	} else if options.Region != "" {
+		// A URL from the external configuration takes precedence over the region.
+		var serviceProps map[string]string
+		serviceProps, err = core.GetServiceProperties(options.ServiceName)
+		if err != nil || serviceProps[core.PROPNAME_SVC_URL] != "" {
+			return
+		}
+		var regionURL string
+		regionURL, err = serviceURLForRegion(options.Region, options.PrivateEndpoint)
+		if err != nil {
+			return
+		}
+		err = schematics.Service.SetServiceURL(regionURL)
 	}
 	return
 }
@@ -106,6 +137,16 @@ func NewSchematicsV1(options *SchematicsV1Options) (service *SchematicsV1, err e
 		if err != nil {
 			return
 		}
+	} else if options.Region != "" {
+		var regionURL string
+		regionURL, err = serviceURLForRegion(options.Region, options.PrivateEndpoint)
+		if err != nil {
+			return
+		}
+		err = baseService.SetServiceURL(regionURL)
+		if err != nil {
+			return
+		}
 	}
 
 	service = &SchematicsV1{
@@ -115,9 +156,50 @@ func NewSchematicsV1(options *SchematicsV1Options) (service *SchematicsV1, err e
 	return
 }
 
+// regionalEndpoints maps each region in which the service is available to its public and private service URLs.
+var regionalEndpoints = map[string]struct {
+	public  string
+	private string
+}{
+	"us-south": {"https://us-south.schematics.cloud.ibm.com", "https://private-us-south.schematics.cloud.ibm.com"},
+	"us-east":  {"https://us-east.schematics.cloud.ibm.com", "https://private-us-east.schematics.cloud.ibm.com"},
+	"eu-de":    {"https://eu-de.schematics.cloud.ibm.com", "https://private-eu-de.schematics.cloud.ibm.com"},
+	"eu-gb":    {"https://eu-gb.schematics.cloud.ibm.com", "https://private-eu-gb.schematics.cloud.ibm.com"},
+	"ca-tor":   {"https://ca-tor.schematics.cloud.ibm.com", "https://private-ca-tor.schematics.cloud.ibm.com"},
+}
+
 // GetServiceURLForRegion returns the service URL to be used for the specified region
 func GetServiceURLForRegion(region string) (string, error) {
-	return "", fmt.Errorf("service does not support regional URLs")
+	if endpoints, ok := regionalEndpoints[region]; ok {
+		return endpoints.public, nil
+	}
+	return "", fmt.Errorf("service URL for region '%s' not found", region)
+}
+
+// GetPrivateServiceURLForRegion returns the private service URL to be used for the specified region
+func GetPrivateServiceURLForRegion(region string) (string, error) {
+	if endpoints, ok := regionalEndpoints[region]; ok {
+		return endpoints.private, nil
+	}
+	return "", fmt.Errorf("private service URL for region '%s' not found", region)
+}
+
+// serviceURLForRegion returns the public or private service URL to be used for the specified region
+func serviceURLForRegion(region string, private bool) (string, error) {
+	if private {
+		return GetPrivateServiceURLForRegion(region)
+	}
+	return GetServiceURLForRegion(region)
+}
+
+// Regions returns the names of the regions in which the service is available, in alphabetical order
+func Regions() []string {
+	regions := make([]string, 0, len(regionalEndpoints))
+	for region := range regionalEndpoints {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+	return regions
 }
 
 // Clone makes a copy of "schematics" suitable for processing requests.
@@ -181,7 +263,7 @@ func (schematics *SchematicsV1) GetSchematicsVersionWithContext(ctx context.Cont
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetSchematicsVersion"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v1/version`, nil)
 	if err != nil {
@@ -192,7 +274,7 @@ func (schematics *SchematicsV1) GetSchematicsVersionWithContext(ctx context.Cont
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetSchematicsVersion")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "GetSchematicsVersion", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -204,8 +286,9 @@ func (schematics *SchematicsV1) GetSchematicsVersionWithContext(ctx context.Cont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -240,7 +323,7 @@ func (schematics *SchematicsV1) ListLocationsWithContext(ctx context.Context, li
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListLocations"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/locations`, nil)
 	if err != nil {
@@ -251,7 +334,7 @@ func (schematics *SchematicsV1) ListLocationsWithContext(ctx context.Context, li
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ListLocations")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "ListLocations", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -263,8 +346,9 @@ func (schematics *SchematicsV1) ListLocationsWithContext(ctx context.Context, li
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -299,7 +383,7 @@ func (schematics *SchematicsV1) ListResourceGroupWithContext(ctx context.Context
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListResourceGroup"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v1/resource_groups`, nil)
 	if err != nil {
@@ -310,7 +394,7 @@ func (schematics *SchematicsV1) ListResourceGroupWithContext(ctx context.Context
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ListResourceGroup")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "ListResourceGroup", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -322,8 +406,9 @@ func (schematics *SchematicsV1) ListResourceGroupWithContext(ctx context.Context
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -358,7 +443,7 @@ func (schematics *SchematicsV1) ListSchematicsLocationWithContext(ctx context.Co
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListSchematicsLocation"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v1/locations`, nil)
 	if err != nil {
@@ -369,7 +454,7 @@ func (schematics *SchematicsV1) ListSchematicsLocationWithContext(ctx context.Co
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ListSchematicsLocation")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "ListSchematicsLocation", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -381,8 +466,9 @@ func (schematics *SchematicsV1) ListSchematicsLocationWithContext(ctx context.Co
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -416,13 +502,19 @@ func (schematics *SchematicsV1) ProcessTemplateMetaDataWithContext(ctx context.C
 	if err != nil {
 		return
 	}
+	if len(schematics.gitTokenProviders) != 0 {
+		processTemplateMetaDataOptions, err = processTemplateMetaDataOptions.withProvidedGitToken(ctx, schematics.gitTokenProviders)
+		if err != nil {
+			return
+		}
+	}
 	err = core.ValidateStruct(processTemplateMetaDataOptions, "processTemplateMetaDataOptions")
 	if err != nil {
 		return
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ProcessTemplateMetaData"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/template_metadata_processor`, nil)
 	if err != nil {
@@ -433,7 +525,7 @@ func (schematics *SchematicsV1) ProcessTemplateMetaDataWithContext(ctx context.C
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ProcessTemplateMetaData")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "ProcessTemplateMetaData", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -467,8 +559,9 @@ func (schematics *SchematicsV1) ProcessTemplateMetaDataWithContext(ctx context.C
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -529,13 +622,19 @@ func (schematics *SchematicsV1) CreateWorkspaceWithContext(ctx context.Context,
 	if err != nil {
 		return
 	}
+	if len(schematics.gitTokenProviders) != 0 {
+		createWorkspaceOptions, err = createWorkspaceOptions.withProvidedGitToken(ctx, schematics.gitTokenProviders)
+		if err != nil {
+			return
+		}
+	}
 	err = core.ValidateStruct(createWorkspaceOptions, "createWorkspaceOptions")
 	if err != nil {
 		return
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "CreateWorkspace"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v1/workspaces`, nil)
 	if err != nil {
@@ -546,7 +645,7 @@ func (schematics *SchematicsV1) CreateWorkspaceWithContext(ctx context.Context,
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "CreateWorkspace")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "CreateWorkspace", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -613,8 +712,9 @@ func (schematics *SchematicsV1) CreateWorkspaceWithContext(ctx context.Context,
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -653,6 +753,12 @@ func (schematics *SchematicsV1) DeleteWorkspaceWithContext(ctx context.Context,
 	if err != nil {
 		return
 	}
+	if schematics.tokenProvider != nil {
+		deleteWorkspaceOptions, err = deleteWorkspaceOptions.withProvidedTokens(ctx, schematics.tokenProvider)
+		if err != nil {
+			return
+		}
+	}
 	err = core.ValidateStruct(deleteWorkspaceOptions, "deleteWorkspaceOptions")
 	if err != nil {
 		return
@@ -663,7 +769,7 @@ func (schematics *SchematicsV1) DeleteWorkspaceWithContext(ctx context.Context,
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "DeleteWorkspace"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v1/workspaces/{w_id}`, pathParamsMap)
 	if err != nil {
@@ -674,7 +780,7 @@ func (schematics *SchematicsV1) DeleteWorkspaceWithContext(ctx context.Context,
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "DeleteWorkspace")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "DeleteWorkspace", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -692,7 +798,10 @@ func (schematics *SchematicsV1) DeleteWorkspaceWithContext(ctx context.Context,
 		return
 	}
 
-	response, err = schematics.Service.Request(request, &result)
+	response, err = schematics.request(request, &result)
+	if err != nil {
+		err = newSchematicsError(err, response)
+	}
 
 	return
 }
@@ -726,7 +835,7 @@ func (schematics *SchematicsV1) GetAllWorkspaceInputsWithContext(ctx context.Con
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetAllWorkspaceInputs"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v1/workspaces/{w_id}/templates/values`, pathParamsMap)
 	if err != nil {
@@ -737,7 +846,7 @@ func (schematics *SchematicsV1) GetAllWorkspaceInputsWithContext(ctx context.Con
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetAllWorkspaceInputs")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "GetAllWorkspaceInputs", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -749,8 +858,9 @@ func (schematics *SchematicsV1) GetAllWorkspaceInputsWithContext(ctx context.Con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -788,7 +898,7 @@ func (schematics *SchematicsV1) GetTemplateActivityLogWithContext(ctx context.Co
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetTemplateActivityLog"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v1/workspaces/{w_id}/runtime_data/{t_id}/log_store/actions/{activity_id}`, pathParamsMap)
 	if err != nil {
@@ -799,7 +909,7 @@ func (schematics *SchematicsV1) GetTemplateActivityLogWithContext(ctx context.Co
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetTemplateActivityLog")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "GetTemplateActivityLog", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -823,7 +933,10 @@ func (schematics *SchematicsV1) GetTemplateActivityLogWithContext(ctx context.Co
 		return
 	}
 
-	response, err = schematics.Service.Request(request, &result)
+	response, err = schematics.request(request, &result)
+	if err != nil {
+		err = newSchematicsError(err, response)
+	}
 
 	return
 }
@@ -858,7 +971,7 @@ func (schematics *SchematicsV1) GetTemplateLogsWithContext(ctx context.Context,
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetTemplateLogs"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v1/workspaces/{w_id}/runtime_data/{t_id}/log_store`, pathParamsMap)
 	if err != nil {
@@ -869,7 +982,7 @@ func (schematics *SchematicsV1) GetTemplateLogsWithContext(ctx context.Context,
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetTemplateLogs")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "GetTemplateLogs", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -893,7 +1006,10 @@ func (schematics *SchematicsV1) GetTemplateLogsWithContext(ctx context.Context,
 		return
 	}
 
-	response, err = schematics.Service.Request(request, &result)
+	response, err = schematics.request(request, &result)
+	if err != nil {
+		err = newSchematicsError(err, response)
+	}
 
 	return
 }
@@ -926,7 +1042,7 @@ func (schematics *SchematicsV1) GetWorkspaceWithContext(ctx context.Context, get
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetWorkspace"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v1/workspaces/{w_id}`, pathParamsMap)
 	if err != nil {
@@ -937,7 +1053,7 @@ func (schematics *SchematicsV1) GetWorkspaceWithContext(ctx context.Context, get
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetWorkspace")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "GetWorkspace", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -949,8 +1065,9 @@ func (schematics *SchematicsV1) GetWorkspaceWithContext(ctx context.Context, get
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -998,7 +1115,7 @@ func (schematics *SchematicsV1) GetWorkspaceActivityLogsWithContext(ctx context.
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetWorkspaceActivityLogs"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v1/workspaces/{w_id}/actions/{activity_id}/logs`, pathParamsMap)
 	if err != nil {
@@ -1009,7 +1126,7 @@ func (schematics *SchematicsV1) GetWorkspaceActivityLogsWithContext(ctx context.
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetWorkspaceActivityLogs")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "GetWorkspaceActivityLogs", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1021,8 +1138,9 @@ func (schematics *SchematicsV1) GetWorkspaceActivityLogsWithContext(ctx context.
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -1060,7 +1178,7 @@ func (schematics *SchematicsV1) GetWorkspaceInputMetadataWithContext(ctx context
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetWorkspaceInputMetadata"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v1/workspaces/{w_id}/template_data/{t_id}/values_metadata`, pathParamsMap)
 	if err != nil {
@@ -1071,7 +1189,7 @@ func (schematics *SchematicsV1) GetWorkspaceInputMetadataWithContext(ctx context
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetWorkspaceInputMetadata")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "GetWorkspaceInputMetadata", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1082,7 +1200,10 @@ func (schematics *SchematicsV1) GetWorkspaceInputMetadataWithContext(ctx context
 		return
 	}
 
-	response, err = schematics.Service.Request(request, &result)
+	response, err = schematics.request(request, &result)
+	if err != nil {
+		err = newSchematicsError(err, response)
+	}
 
 	return
 }
@@ -1117,7 +1238,7 @@ func (schematics *SchematicsV1) GetWorkspaceInputsWithContext(ctx context.Contex
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetWorkspaceInputs"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v1/workspaces/{w_id}/template_data/{t_id}/values`, pathParamsMap)
 	if err != nil {
@@ -1128,7 +1249,7 @@ func (schematics *SchematicsV1) GetWorkspaceInputsWithContext(ctx context.Contex
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetWorkspaceInputs")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "GetWorkspaceInputs", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1140,8 +1261,9 @@ func (schematics *SchematicsV1) GetWorkspaceInputsWithContext(ctx context.Contex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -1181,7 +1303,7 @@ func (schematics *SchematicsV1) GetWorkspaceLogUrlsWithContext(ctx context.Conte
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetWorkspaceLogUrls"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v1/workspaces/{w_id}/log_stores`, pathParamsMap)
 	if err != nil {
@@ -1192,7 +1314,7 @@ func (schematics *SchematicsV1) GetWorkspaceLogUrlsWithContext(ctx context.Conte
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetWorkspaceLogUrls")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "GetWorkspaceLogUrls", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1204,8 +1326,9 @@ func (schematics *SchematicsV1) GetWorkspaceLogUrlsWithContext(ctx context.Conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -1242,7 +1365,7 @@ func (schematics *SchematicsV1) GetWorkspaceOutputsWithContext(ctx context.Conte
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetWorkspaceOutputs"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v1/workspaces/{w_id}/output_values`, pathParamsMap)
 	if err != nil {
@@ -1253,7 +1376,7 @@ func (schematics *SchematicsV1) GetWorkspaceOutputsWithContext(ctx context.Conte
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetWorkspaceOutputs")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "GetWorkspaceOutputs", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1265,8 +1388,9 @@ func (schematics *SchematicsV1) GetWorkspaceOutputsWithContext(ctx context.Conte
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -1305,7 +1429,7 @@ func (schematics *SchematicsV1) GetWorkspaceReadmeWithContext(ctx context.Contex
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetWorkspaceReadme"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v1/workspaces/{w_id}/templates/readme`, pathParamsMap)
 	if err != nil {
@@ -1316,7 +1440,7 @@ func (schematics *SchematicsV1) GetWorkspaceReadmeWithContext(ctx context.Contex
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetWorkspaceReadme")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "GetWorkspaceReadme", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1335,8 +1459,9 @@ func (schematics *SchematicsV1) GetWorkspaceReadmeWithContext(ctx context.Contex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -1372,7 +1497,7 @@ func (schematics *SchematicsV1) GetWorkspaceResourcesWithContext(ctx context.Con
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetWorkspaceResources"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v1/workspaces/{w_id}/resources`, pathParamsMap)
 	if err != nil {
@@ -1383,7 +1508,7 @@ func (schematics *SchematicsV1) GetWorkspaceResourcesWithContext(ctx context.Con
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetWorkspaceResources")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "GetWorkspaceResources", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1395,8 +1520,9 @@ func (schematics *SchematicsV1) GetWorkspaceResourcesWithContext(ctx context.Con
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -1447,7 +1573,7 @@ func (schematics *SchematicsV1) GetWorkspaceStateWithContext(ctx context.Context
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetWorkspaceState"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v1/workspaces/{w_id}/state_stores`, pathParamsMap)
 	if err != nil {
@@ -1458,7 +1584,7 @@ func (schematics *SchematicsV1) GetWorkspaceStateWithContext(ctx context.Context
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetWorkspaceState")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "GetWorkspaceState", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1470,8 +1596,9 @@ func (schematics *SchematicsV1) GetWorkspaceStateWithContext(ctx context.Context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -1515,7 +1642,7 @@ func (schematics *SchematicsV1) GetWorkspaceTemplateStateWithContext(ctx context
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetWorkspaceTemplateState"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v1/workspaces/{w_id}/runtime_data/{t_id}/state_store`, pathParamsMap)
 	if err != nil {
@@ -1526,7 +1653,7 @@ func (schematics *SchematicsV1) GetWorkspaceTemplateStateWithContext(ctx context
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetWorkspaceTemplateState")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "GetWorkspaceTemplateState", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1538,8 +1665,9 @@ func (schematics *SchematicsV1) GetWorkspaceTemplateStateWithContext(ctx context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -1578,7 +1706,7 @@ func (schematics *SchematicsV1) ListWorkspacesWithContext(ctx context.Context, l
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListWorkspaces"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v1/workspaces`, nil)
 	if err != nil {
@@ -1589,7 +1717,7 @@ func (schematics *SchematicsV1) ListWorkspacesWithContext(ctx context.Context, l
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ListWorkspaces")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "ListWorkspaces", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1614,8 +1742,9 @@ func (schematics *SchematicsV1) ListWorkspacesWithContext(ctx context.Context, l
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -1652,6 +1781,12 @@ func (schematics *SchematicsV1) ReplaceWorkspaceWithContext(ctx context.Context,
 	if err != nil {
 		return
 	}
+	if len(schematics.gitTokenProviders) != 0 {
+		replaceWorkspaceOptions, err = replaceWorkspaceOptions.withProvidedGitToken(ctx, schematics.gitTokenProviders)
+		if err != nil {
+			return
+		}
+	}
 	err = core.ValidateStruct(replaceWorkspaceOptions, "replaceWorkspaceOptions")
 	if err != nil {
 		return
@@ -1662,7 +1797,7 @@ func (schematics *SchematicsV1) ReplaceWorkspaceWithContext(ctx context.Context,
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ReplaceWorkspace"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v1/workspaces/{w_id}`, pathParamsMap)
 	if err != nil {
@@ -1673,7 +1808,7 @@ func (schematics *SchematicsV1) ReplaceWorkspaceWithContext(ctx context.Context,
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ReplaceWorkspace")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "ReplaceWorkspace", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1731,8 +1866,9 @@ func (schematics *SchematicsV1) ReplaceWorkspaceWithContext(ctx context.Context,
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -1769,7 +1905,7 @@ func (schematics *SchematicsV1) ReplaceWorkspaceInputsWithContext(ctx context.Co
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ReplaceWorkspaceInputs"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v1/workspaces/{w_id}/template_data/{t_id}/values`, pathParamsMap)
 	if err != nil {
@@ -1780,7 +1916,7 @@ func (schematics *SchematicsV1) ReplaceWorkspaceInputsWithContext(ctx context.Co
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ReplaceWorkspaceInputs")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "ReplaceWorkspaceInputs", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1808,8 +1944,9 @@ func (schematics *SchematicsV1) ReplaceWorkspaceInputsWithContext(ctx context.Co
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -1858,7 +1995,7 @@ func (schematics *SchematicsV1) TemplateRepoUploadWithContext(ctx context.Contex
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "TemplateRepoUpload"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v1/workspaces/{w_id}/template_data/{t_id}/template_repo_upload`, pathParamsMap)
 	if err != nil {
@@ -1869,7 +2006,7 @@ func (schematics *SchematicsV1) TemplateRepoUploadWithContext(ctx context.Contex
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "TemplateRepoUpload")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "TemplateRepoUpload", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1886,8 +2023,9 @@ func (schematics *SchematicsV1) TemplateRepoUploadWithContext(ctx context.Contex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -1942,7 +2080,7 @@ func (schematics *SchematicsV1) UpdateWorkspaceWithContext(ctx context.Context,
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "UpdateWorkspace"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v1/workspaces/{w_id}`, pathParamsMap)
 	if err != nil {
@@ -1953,7 +2091,7 @@ func (schematics *SchematicsV1) UpdateWorkspaceWithContext(ctx context.Context,
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "UpdateWorkspace")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "UpdateWorkspace", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2008,8 +2146,9 @@ func (schematics *SchematicsV1) UpdateWorkspaceWithContext(ctx context.Context,
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -2057,13 +2196,19 @@ func (schematics *SchematicsV1) CreateActionWithContext(ctx context.Context, cre
 	if err != nil {
 		return
 	}
+	if len(schematics.gitTokenProviders) != 0 {
+		createActionOptions, err = createActionOptions.withProvidedGitToken(ctx, schematics.gitTokenProviders)
+		if err != nil {
+			return
+		}
+	}
 	err = core.ValidateStruct(createActionOptions, "createActionOptions")
 	if err != nil {
 		return
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "CreateAction"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/actions`, nil)
 	if err != nil {
@@ -2074,7 +2219,7 @@ func (schematics *SchematicsV1) CreateActionWithContext(ctx context.Context, cre
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "CreateAction")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "CreateAction", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2156,8 +2301,9 @@ func (schematics *SchematicsV1) CreateActionWithContext(ctx context.Context, cre
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -2204,7 +2350,7 @@ func (schematics *SchematicsV1) DeleteActionWithContext(ctx context.Context, del
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "DeleteAction"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/actions/{action_id}`, pathParamsMap)
 	if err != nil {
@@ -2215,7 +2361,7 @@ func (schematics *SchematicsV1) DeleteActionWithContext(ctx context.Context, del
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "DeleteAction")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "DeleteAction", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2231,7 +2377,10 @@ func (schematics *SchematicsV1) DeleteActionWithContext(ctx context.Context, del
 		return
 	}
 
-	response, err = schematics.Service.Request(request, nil)
+	response, err = schematics.request(request, nil)
+	if err != nil {
+		err = newSchematicsError(err, response)
+	}
 
 	return
 }
@@ -2266,7 +2415,7 @@ func (schematics *SchematicsV1) GetActionWithContext(ctx context.Context, getAct
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetAction"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/actions/{action_id}`, pathParamsMap)
 	if err != nil {
@@ -2277,7 +2426,7 @@ func (schematics *SchematicsV1) GetActionWithContext(ctx context.Context, getAct
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetAction")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "GetAction", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2293,8 +2442,9 @@ func (schematics *SchematicsV1) GetActionWithContext(ctx context.Context, getAct
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -2334,7 +2484,7 @@ func (schematics *SchematicsV1) ListActionsWithContext(ctx context.Context, list
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListActions"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/actions`, nil)
 	if err != nil {
@@ -2345,7 +2495,7 @@ func (schematics *SchematicsV1) ListActionsWithContext(ctx context.Context, list
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ListActions")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "ListActions", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2370,8 +2520,9 @@ func (schematics *SchematicsV1) ListActionsWithContext(ctx context.Context, list
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -2414,6 +2565,12 @@ func (schematics *SchematicsV1) UpdateActionWithContext(ctx context.Context, upd
 	if err != nil {
 		return
 	}
+	if len(schematics.gitTokenProviders) != 0 {
+		updateActionOptions, err = updateActionOptions.withProvidedGitToken(ctx, schematics.gitTokenProviders)
+		if err != nil {
+			return
+		}
+	}
 	err = core.ValidateStruct(updateActionOptions, "updateActionOptions")
 	if err != nil {
 		return
@@ -2424,7 +2581,7 @@ func (schematics *SchematicsV1) UpdateActionWithContext(ctx context.Context, upd
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "UpdateAction"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/actions/{action_id}`, pathParamsMap)
 	if err != nil {
@@ -2435,7 +2592,7 @@ func (schematics *SchematicsV1) UpdateActionWithContext(ctx context.Context, upd
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "UpdateAction")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "UpdateAction", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2517,8 +2674,9 @@ func (schematics *SchematicsV1) UpdateActionWithContext(ctx context.Context, upd
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -2566,7 +2724,7 @@ func (schematics *SchematicsV1) UploadTemplateTarActionWithContext(ctx context.C
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "UploadTemplateTarAction"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/actions/{action_id}/template_repo_upload`, pathParamsMap)
 	if err != nil {
@@ -2577,7 +2735,7 @@ func (schematics *SchematicsV1) UploadTemplateTarActionWithContext(ctx context.C
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "UploadTemplateTarAction")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "UploadTemplateTarAction", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2594,8 +2752,9 @@ func (schematics *SchematicsV1) UploadTemplateTarActionWithContext(ctx context.C
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -2650,6 +2809,12 @@ func (schematics *SchematicsV1) ApplyWorkspaceCommandWithContext(ctx context.Con
 	if err != nil {
 		return
 	}
+	if schematics.tokenProvider != nil {
+		applyWorkspaceCommandOptions, err = applyWorkspaceCommandOptions.withProvidedTokens(ctx, schematics.tokenProvider)
+		if err != nil {
+			return
+		}
+	}
 	err = core.ValidateStruct(applyWorkspaceCommandOptions, "applyWorkspaceCommandOptions")
 	if err != nil {
 		return
@@ -2660,7 +2825,7 @@ func (schematics *SchematicsV1) ApplyWorkspaceCommandWithContext(ctx context.Con
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ApplyWorkspaceCommand"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v1/workspaces/{w_id}/apply`, pathParamsMap)
 	if err != nil {
@@ -2671,7 +2836,7 @@ func (schematics *SchematicsV1) ApplyWorkspaceCommandWithContext(ctx context.Con
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ApplyWorkspaceCommand")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "ApplyWorkspaceCommand", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2699,8 +2864,9 @@ func (schematics *SchematicsV1) ApplyWorkspaceCommandWithContext(ctx context.Con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -2727,13 +2893,19 @@ func (schematics *SchematicsV1) CreateJobWithContext(ctx context.Context, create
 	if err != nil {
 		return
 	}
+	if schematics.tokenProvider != nil {
+		createJobOptions, err = createJobOptions.withProvidedTokens(ctx, schematics.tokenProvider)
+		if err != nil {
+			return
+		}
+	}
 	err = core.ValidateStruct(createJobOptions, "createJobOptions")
 	if err != nil {
 		return
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "CreateJob"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/jobs`, nil)
 	if err != nil {
@@ -2744,7 +2916,7 @@ func (schematics *SchematicsV1) CreateJobWithContext(ctx context.Context, create
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "CreateJob")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "CreateJob", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2811,8 +2983,9 @@ func (schematics *SchematicsV1) CreateJobWithContext(ctx context.Context, create
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -2847,6 +3020,12 @@ func (schematics *SchematicsV1) DeleteJobWithContext(ctx context.Context, delete
 	if err != nil {
 		return
 	}
+	if schematics.tokenProvider != nil {
+		deleteJobOptions, err = deleteJobOptions.withProvidedTokens(ctx, schematics.tokenProvider)
+		if err != nil {
+			return
+		}
+	}
 	err = core.ValidateStruct(deleteJobOptions, "deleteJobOptions")
 	if err != nil {
 		return
@@ -2857,7 +3036,7 @@ func (schematics *SchematicsV1) DeleteJobWithContext(ctx context.Context, delete
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "DeleteJob"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/jobs/{job_id}`, pathParamsMap)
 	if err != nil {
@@ -2868,7 +3047,7 @@ func (schematics *SchematicsV1) DeleteJobWithContext(ctx context.Context, delete
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "DeleteJob")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "DeleteJob", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2887,7 +3066,10 @@ func (schematics *SchematicsV1) DeleteJobWithContext(ctx context.Context, delete
 		return
 	}
 
-	response, err = schematics.Service.Request(request, nil)
+	response, err = schematics.request(request, nil)
+	if err != nil {
+		err = newSchematicsError(err, response)
+	}
 
 	return
 }
@@ -2925,7 +3107,7 @@ func (schematics *SchematicsV1) DeleteWorkspaceActivityWithContext(ctx context.C
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "DeleteWorkspaceActivity"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v1/workspaces/{w_id}/actions/{activity_id}`, pathParamsMap)
 	if err != nil {
@@ -2936,7 +3118,7 @@ func (schematics *SchematicsV1) DeleteWorkspaceActivityWithContext(ctx context.C
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "DeleteWorkspaceActivity")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "DeleteWorkspaceActivity", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2948,8 +3130,9 @@ func (schematics *SchematicsV1) DeleteWorkspaceActivityWithContext(ctx context.C
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -2993,6 +3176,12 @@ func (schematics *SchematicsV1) DestroyWorkspaceCommandWithContext(ctx context.C
 	if err != nil {
 		return
 	}
+	if schematics.tokenProvider != nil {
+		destroyWorkspaceCommandOptions, err = destroyWorkspaceCommandOptions.withProvidedTokens(ctx, schematics.tokenProvider)
+		if err != nil {
+			return
+		}
+	}
 	err = core.ValidateStruct(destroyWorkspaceCommandOptions, "destroyWorkspaceCommandOptions")
 	if err != nil {
 		return
@@ -3003,7 +3192,7 @@ func (schematics *SchematicsV1) DestroyWorkspaceCommandWithContext(ctx context.C
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "DestroyWorkspaceCommand"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v1/workspaces/{w_id}/destroy`, pathParamsMap)
 	if err != nil {
@@ -3014,7 +3203,7 @@ func (schematics *SchematicsV1) DestroyWorkspaceCommandWithContext(ctx context.C
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "DestroyWorkspaceCommand")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "DestroyWorkspaceCommand", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3042,8 +3231,9 @@ func (schematics *SchematicsV1) DestroyWorkspaceCommandWithContext(ctx context.C
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -3086,7 +3276,7 @@ func (schematics *SchematicsV1) GetJobWithContext(ctx context.Context, getJobOpt
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetJob"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/jobs/{job_id}`, pathParamsMap)
 	if err != nil {
@@ -3097,7 +3287,7 @@ func (schematics *SchematicsV1) GetJobWithContext(ctx context.Context, getJobOpt
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetJob")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "GetJob", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3113,8 +3303,9 @@ func (schematics *SchematicsV1) GetJobWithContext(ctx context.Context, getJobOpt
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -3152,7 +3343,7 @@ func (schematics *SchematicsV1) GetJobFilesWithContext(ctx context.Context, getJ
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetJobFiles"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/jobs/{job_id}/files`, pathParamsMap)
 	if err != nil {
@@ -3163,7 +3354,7 @@ func (schematics *SchematicsV1) GetJobFilesWithContext(ctx context.Context, getJ
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetJobFiles")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "GetJobFiles", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3177,8 +3368,9 @@ func (schematics *SchematicsV1) GetJobFilesWithContext(ctx context.Context, getJ
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -3216,7 +3408,7 @@ func (schematics *SchematicsV1) GetWorkspaceActivityWithContext(ctx context.Cont
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetWorkspaceActivity"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v1/workspaces/{w_id}/actions/{activity_id}`, pathParamsMap)
 	if err != nil {
@@ -3227,7 +3419,7 @@ func (schematics *SchematicsV1) GetWorkspaceActivityWithContext(ctx context.Cont
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetWorkspaceActivity")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "GetWorkspaceActivity", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3239,8 +3431,9 @@ func (schematics *SchematicsV1) GetWorkspaceActivityWithContext(ctx context.Cont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -3278,7 +3471,7 @@ func (schematics *SchematicsV1) ListJobLogsWithContext(ctx context.Context, list
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListJobLogs"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/jobs/{job_id}/logs`, pathParamsMap)
 	if err != nil {
@@ -3289,7 +3482,7 @@ func (schematics *SchematicsV1) ListJobLogsWithContext(ctx context.Context, list
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ListJobLogs")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "ListJobLogs", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3301,8 +3494,9 @@ func (schematics *SchematicsV1) ListJobLogsWithContext(ctx context.Context, list
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -3339,7 +3533,7 @@ func (schematics *SchematicsV1) ListJobsWithContext(ctx context.Context, listJob
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListJobs"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/jobs`, nil)
 	if err != nil {
@@ -3350,7 +3544,7 @@ func (schematics *SchematicsV1) ListJobsWithContext(ctx context.Context, listJob
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ListJobs")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "ListJobs", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3390,8 +3584,9 @@ func (schematics *SchematicsV1) ListJobsWithContext(ctx context.Context, listJob
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -3428,7 +3623,7 @@ func (schematics *SchematicsV1) ListWorkspaceActivitiesWithContext(ctx context.C
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListWorkspaceActivities"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v1/workspaces/{w_id}/actions`, pathParamsMap)
 	if err != nil {
@@ -3439,7 +3634,7 @@ func (schematics *SchematicsV1) ListWorkspaceActivitiesWithContext(ctx context.C
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ListWorkspaceActivities")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "ListWorkspaceActivities", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3458,8 +3653,9 @@ func (schematics *SchematicsV1) ListWorkspaceActivitiesWithContext(ctx context.C
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -3503,6 +3699,12 @@ func (schematics *SchematicsV1) PlanWorkspaceCommandWithContext(ctx context.Cont
 	if err != nil {
 		return
 	}
+	if schematics.tokenProvider != nil {
+		planWorkspaceCommandOptions, err = planWorkspaceCommandOptions.withProvidedTokens(ctx, schematics.tokenProvider)
+		if err != nil {
+			return
+		}
+	}
 	err = core.ValidateStruct(planWorkspaceCommandOptions, "planWorkspaceCommandOptions")
 	if err != nil {
 		return
@@ -3513,7 +3715,7 @@ func (schematics *SchematicsV1) PlanWorkspaceCommandWithContext(ctx context.Cont
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "PlanWorkspaceCommand"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v1/workspaces/{w_id}/plan`, pathParamsMap)
 	if err != nil {
@@ -3524,7 +3726,7 @@ func (schematics *SchematicsV1) PlanWorkspaceCommandWithContext(ctx context.Cont
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "PlanWorkspaceCommand")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "PlanWorkspaceCommand", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3552,8 +3754,9 @@ func (schematics *SchematicsV1) PlanWorkspaceCommandWithContext(ctx context.Cont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -3589,6 +3792,12 @@ func (schematics *SchematicsV1) RefreshWorkspaceCommandWithContext(ctx context.C
 	if err != nil {
 		return
 	}
+	if schematics.tokenProvider != nil {
+		refreshWorkspaceCommandOptions, err = refreshWorkspaceCommandOptions.withProvidedTokens(ctx, schematics.tokenProvider)
+		if err != nil {
+			return
+		}
+	}
 	err = core.ValidateStruct(refreshWorkspaceCommandOptions, "refreshWorkspaceCommandOptions")
 	if err != nil {
 		return
@@ -3599,7 +3808,7 @@ func (schematics *SchematicsV1) RefreshWorkspaceCommandWithContext(ctx context.C
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "RefreshWorkspaceCommand"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v1/workspaces/{w_id}/refresh`, pathParamsMap)
 	if err != nil {
@@ -3610,7 +3819,7 @@ func (schematics *SchematicsV1) RefreshWorkspaceCommandWithContext(ctx context.C
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "RefreshWorkspaceCommand")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "RefreshWorkspaceCommand", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3628,8 +3837,9 @@ func (schematics *SchematicsV1) RefreshWorkspaceCommandWithContext(ctx context.C
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -3662,6 +3872,12 @@ func (schematics *SchematicsV1) RunWorkspaceCommandsWithContext(ctx context.Cont
 	if err != nil {
 		return
 	}
+	if schematics.tokenProvider != nil {
+		runWorkspaceCommandsOptions, err = runWorkspaceCommandsOptions.withProvidedTokens(ctx, schematics.tokenProvider)
+		if err != nil {
+			return
+		}
+	}
 	err = core.ValidateStruct(runWorkspaceCommandsOptions, "runWorkspaceCommandsOptions")
 	if err != nil {
 		return
@@ -3672,7 +3888,7 @@ func (schematics *SchematicsV1) RunWorkspaceCommandsWithContext(ctx context.Cont
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "RunWorkspaceCommands"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v1/workspaces/{w_id}/commands`, pathParamsMap)
 	if err != nil {
@@ -3683,7 +3899,7 @@ func (schematics *SchematicsV1) RunWorkspaceCommandsWithContext(ctx context.Cont
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "RunWorkspaceCommands")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "RunWorkspaceCommands", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3714,8 +3930,9 @@ func (schematics *SchematicsV1) RunWorkspaceCommandsWithContext(ctx context.Cont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -3749,6 +3966,12 @@ func (schematics *SchematicsV1) UpdateJobWithContext(ctx context.Context, update
 	if err != nil {
 		return
 	}
+	if schematics.tokenProvider != nil {
+		updateJobOptions, err = updateJobOptions.withProvidedTokens(ctx, schematics.tokenProvider)
+		if err != nil {
+			return
+		}
+	}
 	err = core.ValidateStruct(updateJobOptions, "updateJobOptions")
 	if err != nil {
 		return
@@ -3759,7 +3982,7 @@ func (schematics *SchematicsV1) UpdateJobWithContext(ctx context.Context, update
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "UpdateJob"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/jobs/{job_id}`, pathParamsMap)
 	if err != nil {
@@ -3770,7 +3993,7 @@ func (schematics *SchematicsV1) UpdateJobWithContext(ctx context.Context, update
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "UpdateJob")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "UpdateJob", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3837,8 +4060,9 @@ func (schematics *SchematicsV1) UpdateJobWithContext(ctx context.Context, update
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -3872,13 +4096,19 @@ func (schematics *SchematicsV1) CreateWorkspaceDeletionJobWithContext(ctx contex
 	if err != nil {
 		return
 	}
+	if schematics.tokenProvider != nil {
+		createWorkspaceDeletionJobOptions, err = createWorkspaceDeletionJobOptions.withProvidedTokens(ctx, schematics.tokenProvider)
+		if err != nil {
+			return
+		}
+	}
 	err = core.ValidateStruct(createWorkspaceDeletionJobOptions, "createWorkspaceDeletionJobOptions")
 	if err != nil {
 		return
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "CreateWorkspaceDeletionJob"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v1/workspace_jobs`, nil)
 	if err != nil {
@@ -3889,7 +4119,7 @@ func (schematics *SchematicsV1) CreateWorkspaceDeletionJobWithContext(ctx contex
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "CreateWorkspaceDeletionJob")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "CreateWorkspaceDeletionJob", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3920,8 +4150,9 @@ func (schematics *SchematicsV1) CreateWorkspaceDeletionJobWithContext(ctx contex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -3964,7 +4195,7 @@ func (schematics *SchematicsV1) GetWorkspaceDeletionJobStatusWithContext(ctx con
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetWorkspaceDeletionJobStatus"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v1/workspace_jobs/{wj_id}/status`, pathParamsMap)
 	if err != nil {
@@ -3975,7 +4206,7 @@ func (schematics *SchematicsV1) GetWorkspaceDeletionJobStatusWithContext(ctx con
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetWorkspaceDeletionJobStatus")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "GetWorkspaceDeletionJobStatus", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3987,8 +4218,9 @@ func (schematics *SchematicsV1) GetWorkspaceDeletionJobStatusWithContext(ctx con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -4029,13 +4261,19 @@ func (schematics *SchematicsV1) CreateBlueprintWithContext(ctx context.Context,
 	if err != nil {
 		return
 	}
+	if len(schematics.gitTokenProviders) != 0 {
+		createBlueprintOptions, err = createBlueprintOptions.withProvidedGitToken(ctx, schematics.gitTokenProviders)
+		if err != nil {
+			return
+		}
+	}
 	err = core.ValidateStruct(createBlueprintOptions, "createBlueprintOptions")
 	if err != nil {
 		return
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "CreateBlueprint"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/blueprints`, nil)
 	if err != nil {
@@ -4046,7 +4284,7 @@ func (schematics *SchematicsV1) CreateBlueprintWithContext(ctx context.Context,
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "CreateBlueprint")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "CreateBlueprint", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4101,8 +4339,9 @@ func (schematics *SchematicsV1) CreateBlueprintWithContext(ctx context.Context,
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -4148,7 +4387,7 @@ func (schematics *SchematicsV1) DeleteBlueprintWithContext(ctx context.Context,
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "DeleteBlueprint"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/blueprints/{blueprint_id}`, pathParamsMap)
 	if err != nil {
@@ -4159,7 +4398,7 @@ func (schematics *SchematicsV1) DeleteBlueprintWithContext(ctx context.Context,
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "DeleteBlueprint")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "DeleteBlueprint", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4177,7 +4416,10 @@ func (schematics *SchematicsV1) DeleteBlueprintWithContext(ctx context.Context,
 		return
 	}
 
-	response, err = schematics.Service.Request(request, nil)
+	response, err = schematics.request(request, nil)
+	if err != nil {
+		err = newSchematicsError(err, response)
+	}
 
 	return
 }
@@ -4212,7 +4454,7 @@ func (schematics *SchematicsV1) GetBlueprintWithContext(ctx context.Context, get
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetBlueprint"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/blueprints/{blueprint_id}`, pathParamsMap)
 	if err != nil {
@@ -4223,7 +4465,7 @@ func (schematics *SchematicsV1) GetBlueprintWithContext(ctx context.Context, get
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetBlueprint")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "GetBlueprint", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4239,8 +4481,9 @@ func (schematics *SchematicsV1) GetBlueprintWithContext(ctx context.Context, get
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -4278,7 +4521,7 @@ func (schematics *SchematicsV1) ListBlueprintWithContext(ctx context.Context, li
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListBlueprint"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/blueprints`, nil)
 	if err != nil {
@@ -4289,7 +4532,7 @@ func (schematics *SchematicsV1) ListBlueprintWithContext(ctx context.Context, li
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ListBlueprint")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "ListBlueprint", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4308,8 +4551,9 @@ func (schematics *SchematicsV1) ListBlueprintWithContext(ctx context.Context, li
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -4343,6 +4587,12 @@ func (schematics *SchematicsV1) ReplaceBlueprintWithContext(ctx context.Context,
 	if err != nil {
 		return
 	}
+	if len(schematics.gitTokenProviders) != 0 {
+		replaceBlueprintOptions, err = replaceBlueprintOptions.withProvidedGitToken(ctx, schematics.gitTokenProviders)
+		if err != nil {
+			return
+		}
+	}
 	err = core.ValidateStruct(replaceBlueprintOptions, "replaceBlueprintOptions")
 	if err != nil {
 		return
@@ -4353,7 +4603,7 @@ func (schematics *SchematicsV1) ReplaceBlueprintWithContext(ctx context.Context,
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ReplaceBlueprint"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/blueprints/{blueprint_id}`, pathParamsMap)
 	if err != nil {
@@ -4364,7 +4614,7 @@ func (schematics *SchematicsV1) ReplaceBlueprintWithContext(ctx context.Context,
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ReplaceBlueprint")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "ReplaceBlueprint", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4423,8 +4673,9 @@ func (schematics *SchematicsV1) ReplaceBlueprintWithContext(ctx context.Context,
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -4470,7 +4721,7 @@ func (schematics *SchematicsV1) UploadTemplateTarBlueprintWithContext(ctx contex
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "UploadTemplateTarBlueprint"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/blueprints/{blueprint_id}/template_repo_upload`, pathParamsMap)
 	if err != nil {
@@ -4481,7 +4732,7 @@ func (schematics *SchematicsV1) UploadTemplateTarBlueprintWithContext(ctx contex
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "UploadTemplateTarBlueprint")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "UploadTemplateTarBlueprint", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4498,8 +4749,9 @@ func (schematics *SchematicsV1) UploadTemplateTarBlueprintWithContext(ctx contex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -4545,7 +4797,7 @@ func (schematics *SchematicsV1) CreateInventoryWithContext(ctx context.Context,
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "CreateInventory"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/inventories`, nil)
 	if err != nil {
@@ -4556,7 +4808,7 @@ func (schematics *SchematicsV1) CreateInventoryWithContext(ctx context.Context,
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "CreateInventory")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "CreateInventory", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4593,8 +4845,9 @@ func (schematics *SchematicsV1) CreateInventoryWithContext(ctx context.Context,
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -4639,7 +4892,7 @@ func (schematics *SchematicsV1) CreateResourceQueryWithContext(ctx context.Conte
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "CreateResourceQuery"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/resources_query`, nil)
 	if err != nil {
@@ -4650,7 +4903,7 @@ func (schematics *SchematicsV1) CreateResourceQueryWithContext(ctx context.Conte
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "CreateResourceQuery")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "CreateResourceQuery", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4678,8 +4931,9 @@ func (schematics *SchematicsV1) CreateResourceQueryWithContext(ctx context.Conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -4728,7 +4982,7 @@ func (schematics *SchematicsV1) DeleteInventoryWithContext(ctx context.Context,
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "DeleteInventory"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/inventories/{inventory_id}`, pathParamsMap)
 	if err != nil {
@@ -4739,7 +4993,7 @@ func (schematics *SchematicsV1) DeleteInventoryWithContext(ctx context.Context,
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "DeleteInventory")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "DeleteInventory", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4755,7 +5009,10 @@ func (schematics *SchematicsV1) DeleteInventoryWithContext(ctx context.Context,
 		return
 	}
 
-	response, err = schematics.Service.Request(request, nil)
+	response, err = schematics.request(request, nil)
+	if err != nil {
+		err = newSchematicsError(err, response)
+	}
 
 	return
 }
@@ -4791,7 +5048,7 @@ func (schematics *SchematicsV1) DeleteResourcesQueryWithContext(ctx context.Cont
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "DeleteResourcesQuery"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/resources_query/{query_id}`, pathParamsMap)
 	if err != nil {
@@ -4802,7 +5059,7 @@ func (schematics *SchematicsV1) DeleteResourcesQueryWithContext(ctx context.Cont
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "DeleteResourcesQuery")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "DeleteResourcesQuery", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4818,7 +5075,10 @@ func (schematics *SchematicsV1) DeleteResourcesQueryWithContext(ctx context.Cont
 		return
 	}
 
-	response, err = schematics.Service.Request(request, nil)
+	response, err = schematics.request(request, nil)
+	if err != nil {
+		err = newSchematicsError(err, response)
+	}
 
 	return
 }
@@ -4845,7 +5105,7 @@ func (schematics *SchematicsV1) ExecuteResourceQueryWithContext(ctx context.Cont
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ExecuteResourceQuery"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/resources_query/{query_id}`, pathParamsMap)
 	if err != nil {
@@ -4856,7 +5116,7 @@ func (schematics *SchematicsV1) ExecuteResourceQueryWithContext(ctx context.Cont
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ExecuteResourceQuery")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "ExecuteResourceQuery", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4868,8 +5128,9 @@ func (schematics *SchematicsV1) ExecuteResourceQueryWithContext(ctx context.Cont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -4918,7 +5179,7 @@ func (schematics *SchematicsV1) GetInventoryWithContext(ctx context.Context, get
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetInventory"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/inventories/{inventory_id}`, pathParamsMap)
 	if err != nil {
@@ -4929,7 +5190,7 @@ func (schematics *SchematicsV1) GetInventoryWithContext(ctx context.Context, get
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetInventory")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "GetInventory", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4945,8 +5206,9 @@ func (schematics *SchematicsV1) GetInventoryWithContext(ctx context.Context, get
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -4990,7 +5252,7 @@ func (schematics *SchematicsV1) GetResourcesQueryWithContext(ctx context.Context
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetResourcesQuery"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/resources_query/{query_id}`, pathParamsMap)
 	if err != nil {
@@ -5001,7 +5263,7 @@ func (schematics *SchematicsV1) GetResourcesQueryWithContext(ctx context.Context
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetResourcesQuery")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "GetResourcesQuery", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -5013,8 +5275,9 @@ func (schematics *SchematicsV1) GetResourcesQueryWithContext(ctx context.Context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -5052,7 +5315,7 @@ func (schematics *SchematicsV1) ListInventoriesWithContext(ctx context.Context,
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListInventories"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/inventories`, nil)
 	if err != nil {
@@ -5063,7 +5326,7 @@ func (schematics *SchematicsV1) ListInventoriesWithContext(ctx context.Context,
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ListInventories")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "ListInventories", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -5088,8 +5351,9 @@ func (schematics *SchematicsV1) ListInventoriesWithContext(ctx context.Context,
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -5126,7 +5390,7 @@ func (schematics *SchematicsV1) ListResourceQueryWithContext(ctx context.Context
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListResourceQuery"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/resources_query`, nil)
 	if err != nil {
@@ -5137,7 +5401,7 @@ func (schematics *SchematicsV1) ListResourceQueryWithContext(ctx context.Context
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ListResourceQuery")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "ListResourceQuery", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -5162,8 +5426,9 @@ func (schematics *SchematicsV1) ListResourceQueryWithContext(ctx context.Context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -5212,7 +5477,7 @@ func (schematics *SchematicsV1) ReplaceInventoryWithContext(ctx context.Context,
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ReplaceInventory"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/inventories/{inventory_id}`, pathParamsMap)
 	if err != nil {
@@ -5223,7 +5488,7 @@ func (schematics *SchematicsV1) ReplaceInventoryWithContext(ctx context.Context,
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ReplaceInventory")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "ReplaceInventory", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -5260,8 +5525,9 @@ func (schematics *SchematicsV1) ReplaceInventoryWithContext(ctx context.Context,
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -5308,7 +5574,7 @@ func (schematics *SchematicsV1) ReplaceResourcesQueryWithContext(ctx context.Con
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ReplaceResourcesQuery"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/resources_query/{query_id}`, pathParamsMap)
 	if err != nil {
@@ -5319,7 +5585,7 @@ func (schematics *SchematicsV1) ReplaceResourcesQueryWithContext(ctx context.Con
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ReplaceResourcesQuery")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "ReplaceResourcesQuery", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -5347,8 +5613,9 @@ func (schematics *SchematicsV1) ReplaceResourcesQueryWithContext(ctx context.Con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -5400,7 +5667,7 @@ func (schematics *SchematicsV1) CreateAgentDataWithContext(ctx context.Context,
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "CreateAgentData"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/agents`, nil)
 	if err != nil {
@@ -5411,7 +5678,7 @@ func (schematics *SchematicsV1) CreateAgentDataWithContext(ctx context.Context,
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "CreateAgentData")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "CreateAgentData", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -5466,8 +5733,9 @@ func (schematics *SchematicsV1) CreateAgentDataWithContext(ctx context.Context,
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -5512,7 +5780,7 @@ func (schematics *SchematicsV1) DeleteAgentWithContext(ctx context.Context, dele
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "DeleteAgent"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/settings/agents/{agent_id}`, pathParamsMap)
 	if err != nil {
@@ -5523,7 +5791,7 @@ func (schematics *SchematicsV1) DeleteAgentWithContext(ctx context.Context, dele
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "DeleteAgent")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "DeleteAgent", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -5533,7 +5801,10 @@ func (schematics *SchematicsV1) DeleteAgentWithContext(ctx context.Context, dele
 		return
 	}
 
-	response, err = schematics.Service.Request(request, nil)
+	response, err = schematics.request(request, nil)
+	if err != nil {
+		err = newSchematicsError(err, response)
+	}
 
 	return
 }
@@ -5570,7 +5841,7 @@ func (schematics *SchematicsV1) DeleteAgentDataWithContext(ctx context.Context,
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "DeleteAgentData"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/agents/{agent_id}`, pathParamsMap)
 	if err != nil {
@@ -5581,7 +5852,7 @@ func (schematics *SchematicsV1) DeleteAgentDataWithContext(ctx context.Context,
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "DeleteAgentData")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "DeleteAgentData", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -5591,7 +5862,10 @@ func (schematics *SchematicsV1) DeleteAgentDataWithContext(ctx context.Context,
 		return
 	}
 
-	response, err = schematics.Service.Request(request, nil)
+	response, err = schematics.request(request, nil)
+	if err != nil {
+		err = newSchematicsError(err, response)
+	}
 
 	return
 }
@@ -5622,7 +5896,7 @@ func (schematics *SchematicsV1) DeployAgentJobWithContext(ctx context.Context, d
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "DeployAgentJob"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/agents/{agent_id}/deploy`, pathParamsMap)
 	if err != nil {
@@ -5633,7 +5907,7 @@ func (schematics *SchematicsV1) DeployAgentJobWithContext(ctx context.Context, d
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "DeployAgentJob")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "DeployAgentJob", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -5649,8 +5923,9 @@ func (schematics *SchematicsV1) DeployAgentJobWithContext(ctx context.Context, d
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -5695,7 +5970,7 @@ func (schematics *SchematicsV1) GetAgentWithContext(ctx context.Context, getAgen
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetAgent"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/settings/agents/{agent_id}`, pathParamsMap)
 	if err != nil {
@@ -5706,7 +5981,7 @@ func (schematics *SchematicsV1) GetAgentWithContext(ctx context.Context, getAgen
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetAgent")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "GetAgent", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -5722,8 +5997,9 @@ func (schematics *SchematicsV1) GetAgentWithContext(ctx context.Context, getAgen
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -5770,7 +6046,7 @@ func (schematics *SchematicsV1) GetAgentDataWithContext(ctx context.Context, get
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetAgentData"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/agents/{agent_id}`, pathParamsMap)
 	if err != nil {
@@ -5781,7 +6057,7 @@ func (schematics *SchematicsV1) GetAgentDataWithContext(ctx context.Context, get
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetAgentData")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "GetAgentData", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -5797,8 +6073,9 @@ func (schematics *SchematicsV1) GetAgentDataWithContext(ctx context.Context, get
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -5833,7 +6110,7 @@ func (schematics *SchematicsV1) GetAgentVersionsWithContext(ctx context.Context,
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetAgentVersions"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/agents/versions`, nil)
 	if err != nil {
@@ -5844,7 +6121,7 @@ func (schematics *SchematicsV1) GetAgentVersionsWithContext(ctx context.Context,
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetAgentVersions")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "GetAgentVersions", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -5856,8 +6133,9 @@ func (schematics *SchematicsV1) GetAgentVersionsWithContext(ctx context.Context,
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -5898,7 +6176,7 @@ func (schematics *SchematicsV1) GetDeployAgentJobWithContext(ctx context.Context
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetDeployAgentJob"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/agents/{agent_id}/deploy`, pathParamsMap)
 	if err != nil {
@@ -5909,7 +6187,7 @@ func (schematics *SchematicsV1) GetDeployAgentJobWithContext(ctx context.Context
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetDeployAgentJob")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "GetDeployAgentJob", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -5921,8 +6199,9 @@ func (schematics *SchematicsV1) GetDeployAgentJobWithContext(ctx context.Context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -5964,7 +6243,7 @@ func (schematics *SchematicsV1) GetHealthCheckAgentJobWithContext(ctx context.Co
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetHealthCheckAgentJob"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/agents/{agent_id}/health`, pathParamsMap)
 	if err != nil {
@@ -5975,7 +6254,7 @@ func (schematics *SchematicsV1) GetHealthCheckAgentJobWithContext(ctx context.Co
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetHealthCheckAgentJob")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "GetHealthCheckAgentJob", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -5987,8 +6266,9 @@ func (schematics *SchematicsV1) GetHealthCheckAgentJobWithContext(ctx context.Co
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -6032,7 +6312,7 @@ func (schematics *SchematicsV1) GetPrsAgentJobWithContext(ctx context.Context, g
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetPrsAgentJob"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/agents/{agent_id}/prs`, pathParamsMap)
 	if err != nil {
@@ -6043,7 +6323,7 @@ func (schematics *SchematicsV1) GetPrsAgentJobWithContext(ctx context.Context, g
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetPrsAgentJob")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "GetPrsAgentJob", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -6055,8 +6335,9 @@ func (schematics *SchematicsV1) GetPrsAgentJobWithContext(ctx context.Context, g
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -6096,7 +6377,7 @@ func (schematics *SchematicsV1) HealthCheckAgentJobWithContext(ctx context.Conte
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "HealthCheckAgentJob"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/agents/{agent_id}/health`, pathParamsMap)
 	if err != nil {
@@ -6107,7 +6388,7 @@ func (schematics *SchematicsV1) HealthCheckAgentJobWithContext(ctx context.Conte
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "HealthCheckAgentJob")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "HealthCheckAgentJob", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -6123,8 +6404,9 @@ func (schematics *SchematicsV1) HealthCheckAgentJobWithContext(ctx context.Conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -6161,7 +6443,7 @@ func (schematics *SchematicsV1) ListAgentWithContext(ctx context.Context, listAg
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListAgent"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/settings/agents`, nil)
 	if err != nil {
@@ -6172,7 +6454,7 @@ func (schematics *SchematicsV1) ListAgentWithContext(ctx context.Context, listAg
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ListAgent")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "ListAgent", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -6197,8 +6479,9 @@ func (schematics *SchematicsV1) ListAgentWithContext(ctx context.Context, listAg
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -6238,7 +6521,7 @@ func (schematics *SchematicsV1) ListAgentDataWithContext(ctx context.Context, li
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListAgentData"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/agents`, nil)
 	if err != nil {
@@ -6249,7 +6532,7 @@ func (schematics *SchematicsV1) ListAgentDataWithContext(ctx context.Context, li
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ListAgentData")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "ListAgentData", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -6274,8 +6557,9 @@ func (schematics *SchematicsV1) ListAgentDataWithContext(ctx context.Context, li
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -6316,7 +6600,7 @@ func (schematics *SchematicsV1) PrsAgentJobWithContext(ctx context.Context, prsA
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "PrsAgentJob"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/agents/{agent_id}/prs`, pathParamsMap)
 	if err != nil {
@@ -6327,7 +6611,7 @@ func (schematics *SchematicsV1) PrsAgentJobWithContext(ctx context.Context, prsA
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "PrsAgentJob")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "PrsAgentJob", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -6343,8 +6627,9 @@ func (schematics *SchematicsV1) PrsAgentJobWithContext(ctx context.Context, prsA
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -6385,7 +6670,7 @@ func (schematics *SchematicsV1) RegisterAgentWithContext(ctx context.Context, re
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "RegisterAgent"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/settings/agents`, nil)
 	if err != nil {
@@ -6396,7 +6681,7 @@ func (schematics *SchematicsV1) RegisterAgentWithContext(ctx context.Context, re
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "RegisterAgent")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "RegisterAgent", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -6439,8 +6724,9 @@ func (schematics *SchematicsV1) RegisterAgentWithContext(ctx context.Context, re
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -6486,7 +6772,7 @@ func (schematics *SchematicsV1) UpdateAgentDataWithContext(ctx context.Context,
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "UpdateAgentData"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/agents/{agent_id}`, pathParamsMap)
 	if err != nil {
@@ -6497,7 +6783,7 @@ func (schematics *SchematicsV1) UpdateAgentDataWithContext(ctx context.Context,
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "UpdateAgentData")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "UpdateAgentData", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -6552,8 +6838,9 @@ func (schematics *SchematicsV1) UpdateAgentDataWithContext(ctx context.Context,
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -6598,7 +6885,7 @@ func (schematics *SchematicsV1) UpdateAgentRegistrationWithContext(ctx context.C
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "UpdateAgentRegistration"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/settings/agents/{agent_id}`, pathParamsMap)
 	if err != nil {
@@ -6609,7 +6896,7 @@ func (schematics *SchematicsV1) UpdateAgentRegistrationWithContext(ctx context.C
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "UpdateAgentRegistration")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "UpdateAgentRegistration", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -6652,8 +6939,9 @@ func (schematics *SchematicsV1) UpdateAgentRegistrationWithContext(ctx context.C
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -6693,7 +6981,7 @@ func (schematics *SchematicsV1) GetKmsSettingsWithContext(ctx context.Context, g
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetKmsSettings"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/settings/kms`, nil)
 	if err != nil {
@@ -6704,7 +6992,7 @@ func (schematics *SchematicsV1) GetKmsSettingsWithContext(ctx context.Context, g
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetKmsSettings")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "GetKmsSettings", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -6718,8 +7006,9 @@ func (schematics *SchematicsV1) GetKmsSettingsWithContext(ctx context.Context, g
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -6759,7 +7048,7 @@ func (schematics *SchematicsV1) ListKmsWithContext(ctx context.Context, listKmsO
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListKms"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/settings/kms_instances`, nil)
 	if err != nil {
@@ -6770,7 +7059,7 @@ func (schematics *SchematicsV1) ListKmsWithContext(ctx context.Context, listKmsO
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ListKms")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "ListKms", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -6794,8 +7083,9 @@ func (schematics *SchematicsV1) ListKmsWithContext(ctx context.Context, listKmsO
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -6835,7 +7125,7 @@ func (schematics *SchematicsV1) UpdateKmsSettingsWithContext(ctx context.Context
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "UpdateKmsSettings"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/settings/kms`, nil)
 	if err != nil {
@@ -6846,7 +7136,7 @@ func (schematics *SchematicsV1) UpdateKmsSettingsWithContext(ctx context.Context
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "UpdateKmsSettings")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "UpdateKmsSettings", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -6880,8 +7170,9 @@ func (schematics *SchematicsV1) UpdateKmsSettingsWithContext(ctx context.Context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -6923,7 +7214,7 @@ func (schematics *SchematicsV1) CreatePolicyWithContext(ctx context.Context, cre
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "CreatePolicy"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/settings/policies`, nil)
 	if err != nil {
@@ -6934,7 +7225,7 @@ func (schematics *SchematicsV1) CreatePolicyWithContext(ctx context.Context, cre
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "CreatePolicy")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "CreatePolicy", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -6983,8 +7274,9 @@ func (schematics *SchematicsV1) CreatePolicyWithContext(ctx context.Context, cre
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -7030,7 +7322,7 @@ func (schematics *SchematicsV1) DeletePolicyWithContext(ctx context.Context, del
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "DeletePolicy"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/settings/policies/{policy_id}`, pathParamsMap)
 	if err != nil {
@@ -7041,7 +7333,7 @@ func (schematics *SchematicsV1) DeletePolicyWithContext(ctx context.Context, del
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "DeletePolicy")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "DeletePolicy", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -7051,7 +7343,10 @@ func (schematics *SchematicsV1) DeletePolicyWithContext(ctx context.Context, del
 		return
 	}
 
-	response, err = schematics.Service.Request(request, nil)
+	response, err = schematics.request(request, nil)
+	if err != nil {
+		err = newSchematicsError(err, response)
+	}
 
 	return
 }
@@ -7088,7 +7383,7 @@ func (schematics *SchematicsV1) GetPolicyWithContext(ctx context.Context, getPol
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetPolicy"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/settings/policies/{policy_id}`, pathParamsMap)
 	if err != nil {
@@ -7099,7 +7394,7 @@ func (schematics *SchematicsV1) GetPolicyWithContext(ctx context.Context, getPol
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetPolicy")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "GetPolicy", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -7115,8 +7410,9 @@ func (schematics *SchematicsV1) GetPolicyWithContext(ctx context.Context, getPol
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -7153,7 +7449,7 @@ func (schematics *SchematicsV1) ListPolicyWithContext(ctx context.Context, listP
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListPolicy"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/settings/policies`, nil)
 	if err != nil {
@@ -7164,7 +7460,7 @@ func (schematics *SchematicsV1) ListPolicyWithContext(ctx context.Context, listP
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ListPolicy")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "ListPolicy", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -7186,8 +7482,9 @@ func (schematics *SchematicsV1) ListPolicyWithContext(ctx context.Context, listP
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -7235,7 +7532,7 @@ func (schematics *SchematicsV1) UpdatePolicyWithContext(ctx context.Context, upd
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "UpdatePolicy"))
 	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v2/settings/policies/{policy_id}`, pathParamsMap)
 	if err != nil {
@@ -7246,7 +7543,7 @@ func (schematics *SchematicsV1) UpdatePolicyWithContext(ctx context.Context, upd
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "UpdatePolicy")
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "UpdatePolicy", schematics.application)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -7295,8 +7592,9 @@ func (schematics *SchematicsV1) UpdatePolicyWithContext(ctx context.Context, upd
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
+	response, err = schematics.request(request, &rawResponse)
 	if err != nil {
+		err = newSchematicsError(err, response)
 		return
 	}
 	if rawResponse != nil {
@@ -161,6 +161,115 @@ var _ = Describe(`SchematicsV1`, func() {
 			Expect(url).To(BeEmpty())
 			Expect(err).ToNot(BeNil())
 			fmt.Fprintf(GinkgoWriter, "Expected error: %s\n", err.Error())
+
+			url, err = schematicsv1.GetServiceURLForRegion("us-south")
+			Expect(url).To(Equal("https://us-south.schematics.cloud.ibm.com"))
+			Expect(err).To(BeNil())
+
+			url, err = schematicsv1.GetServiceURLForRegion("us-east")
+			Expect(url).To(Equal("https://us-east.schematics.cloud.ibm.com"))
+			Expect(err).To(BeNil())
+
+			url, err = schematicsv1.GetServiceURLForRegion("eu-de")
+			Expect(url).To(Equal("https://eu-de.schematics.cloud.ibm.com"))
+			Expect(err).To(BeNil())
+
+			url, err = schematicsv1.GetServiceURLForRegion("eu-gb")
+			Expect(url).To(Equal("https://eu-gb.schematics.cloud.ibm.com"))
+			Expect(err).To(BeNil())
+
+			url, err = schematicsv1.GetServiceURLForRegion("ca-tor")
+			Expect(url).To(Equal("https://ca-tor.schematics.cloud.ibm.com"))
+			Expect(err).To(BeNil())
+		})
+		It(`Regions()`, func() {
+			Expect(schematicsv1.Regions()).To(Equal([]string{"ca-tor", "eu-de", "eu-gb", "us-east", "us-south"}))
+		})
+		It(`GetPrivateServiceURLForRegion(region string)`, func() {
+			var url string
+			var err error
+			url, err = schematicsv1.GetPrivateServiceURLForRegion("INVALID_REGION")
+			Expect(url).To(BeEmpty())
+			Expect(err).ToNot(BeNil())
+			fmt.Fprintf(GinkgoWriter, "Expected error: %s\n", err.Error())
+
+			url, err = schematicsv1.GetPrivateServiceURLForRegion("us-south")
+			Expect(url).To(Equal("https://private-us-south.schematics.cloud.ibm.com"))
+			Expect(err).To(BeNil())
+
+			url, err = schematicsv1.GetPrivateServiceURLForRegion("eu-gb")
+			Expect(url).To(Equal("https://private-eu-gb.schematics.cloud.ibm.com"))
+			Expect(err).To(BeNil())
+		})
+		It(`Instantiate service client using Region`, func() {
+			schematicsService, serviceErr := schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+				Region:        "eu-de",
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+			Expect(schematicsService.GetServiceURL()).To(Equal("https://eu-de.schematics.cloud.ibm.com"))
+		})
+		It(`Instantiate service client using Region and URL`, func() {
+			schematicsService, serviceErr := schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+				URL:           "https://testService/api",
+				Region:        "eu-de",
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+			Expect(schematicsService.GetServiceURL()).To(Equal("https://testService/api"))
+		})
+		It(`Instantiate service client with error: Invalid Region`, func() {
+			schematicsService, serviceErr := schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+				Region:        "INVALID_REGION",
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(schematicsService).To(BeNil())
+			Expect(serviceErr).ToNot(BeNil())
+		})
+		It(`Instantiate service client using external config and Region`, func() {
+			testEnvironment := map[string]string{
+				"SCHEMATICS_URL":       "https://schematicsv1/api",
+				"SCHEMATICS_AUTH_TYPE": "noauth",
+			}
+			SetTestEnvironment(testEnvironment)
+			schematicsService, serviceErr := schematicsv1.NewSchematicsV1UsingExternalConfig(&schematicsv1.SchematicsV1Options{
+				Region: "ca-tor",
+			})
+			ClearTestEnvironment(testEnvironment)
+			Expect(serviceErr).To(BeNil())
+			Expect(schematicsService.GetServiceURL()).To(Equal("https://schematicsv1/api"))
+
+			testEnvironment = map[string]string{
+				"SCHEMATICS_AUTH_TYPE": "noauth",
+			}
+			SetTestEnvironment(testEnvironment)
+			schematicsService, serviceErr = schematicsv1.NewSchematicsV1UsingExternalConfig(&schematicsv1.SchematicsV1Options{
+				Region: "ca-tor",
+			})
+			ClearTestEnvironment(testEnvironment)
+			Expect(serviceErr).To(BeNil())
+			Expect(schematicsService.GetServiceURL()).To(Equal("https://ca-tor.schematics.cloud.ibm.com"))
+		})
+		It(`Instantiate service client using Region and PrivateEndpoint`, func() {
+			schematicsService, serviceErr := schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+				Region:          "us-east",
+				PrivateEndpoint: true,
+				Authenticator:   &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+			Expect(schematicsService.GetServiceURL()).To(Equal("https://private-us-east.schematics.cloud.ibm.com"))
+
+			testEnvironment := map[string]string{
+				"SCHEMATICS_AUTH_TYPE": "noauth",
+			}
+			SetTestEnvironment(testEnvironment)
+			schematicsService, serviceErr = schematicsv1.NewSchematicsV1UsingExternalConfig(&schematicsv1.SchematicsV1Options{
+				Region:          "eu-gb",
+				PrivateEndpoint: true,
+			})
+			ClearTestEnvironment(testEnvironment)
+			Expect(serviceErr).To(BeNil())
+			Expect(schematicsService.GetServiceURL()).To(Equal("https://private-eu-gb.schematics.cloud.ibm.com"))
 		})
 	})
 	Describe(`GetSchematicsVersion(getSchematicsVersionOptions *GetSchematicsVersionOptions) - Operation response error`, func() {
@@ -0,0 +1,171 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicstest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// ErrNotStubbed : The error wrapped by the errors returned by the operations of FakeSchematicsV1 that have no stub.
+var ErrNotStubbed = errors.New("schematicstest: operation not stubbed")
+
+// Call : A call to an operation of FakeSchematicsV1.
+type Call struct {
+	// The name of the operation, without the WithContext suffix.
+	Method string
+
+	// The context of the call; the background context for the variants without a context.
+	Context context.Context
+
+	// The options of the call, e.g. a *schematicsv1.GetWorkspaceOptions.
+	Options interface{}
+}
+
+// TestingT : The subset of testing.TB used by the assertions of FakeSchematicsV1, also implemented by GinkgoT().
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+}
+
+// calls records the calls to the operations of FakeSchematicsV1.
+type calls struct {
+	mutex sync.Mutex
+	calls []Call
+}
+
+// record records a call to "method".
+func (calls *calls) record(method string, ctx context.Context, options interface{}) {
+	calls.mutex.Lock()
+	defer calls.mutex.Unlock()
+	calls.calls = append(calls.calls, Call{Method: method, Context: ctx, Options: options})
+}
+
+// call returns the i-th call to "method". It panics if there is no such call, like an out of range index.
+func (calls *calls) call(method string, i int) Call {
+	matching := calls.CallsTo(method)
+	if i < 0 || i >= len(matching) {
+		panic(fmt.Sprintf("schematicstest: %s was called %d times, not %d", method, len(matching), i+1))
+	}
+	return matching[i]
+}
+
+// Calls : Returns all the calls, in the order in which they were made.
+func (calls *calls) Calls() []Call {
+	calls.mutex.Lock()
+	defer calls.mutex.Unlock()
+	return append([]Call{}, calls.calls...)
+}
+
+// CallsTo : Returns the calls to an operation, in the order in which they were made.
+func (calls *calls) CallsTo(method string) []Call {
+	calls.mutex.Lock()
+	defer calls.mutex.Unlock()
+	var matching []Call
+	for _, call := range calls.calls {
+		if call.Method == method {
+			matching = append(matching, call)
+		}
+	}
+	return matching
+}
+
+// CallCount : Returns the number of calls to an operation.
+func (calls *calls) CallCount(method string) int {
+	return len(calls.CallsTo(method))
+}
+
+// ResetCalls : Forgets the recorded calls. The stubs are kept.
+func (calls *calls) ResetCalls() {
+	calls.mutex.Lock()
+	defer calls.mutex.Unlock()
+	calls.calls = nil
+}
+
+// AssertCalled : Asserts that an operation was called at least once.
+func (calls *calls) AssertCalled(t TestingT, method string) bool {
+	t.Helper()
+	if calls.CallCount(method) == 0 {
+		t.Errorf("expected %s to be called", method)
+		return false
+	}
+	return true
+}
+
+// AssertNotCalled : Asserts that an operation was not called.
+func (calls *calls) AssertNotCalled(t TestingT, method string) bool {
+	t.Helper()
+	if count := calls.CallCount(method); count != 0 {
+		t.Errorf("expected %s not to be called, but it was called %d times", method, count)
+		return false
+	}
+	return true
+}
+
+// AssertNumberOfCalls : Asserts that an operation was called exactly "expected" times.
+func (calls *calls) AssertNumberOfCalls(t TestingT, method string, expected int) bool {
+	t.Helper()
+	if count := calls.CallCount(method); count != expected {
+		t.Errorf("expected %s to be called %d times, but it was called %d times", method, expected, count)
+		return false
+	}
+	return true
+}
+
+// AssertCalledWith : Asserts that an operation was called at least once with options deeply equal to "options".
+func (calls *calls) AssertCalledWith(t TestingT, method string, options interface{}) bool {
+	t.Helper()
+	matching := calls.CallsTo(method)
+	for _, call := range matching {
+		if reflect.DeepEqual(call.Options, options) {
+			return true
+		}
+	}
+	t.Errorf("expected %s to be called with %s, but it was called %d times with other options", method, describe(options), len(matching))
+	return false
+}
+
+// AssertCalledMatching : Asserts that an operation was called at least once with options for which "match" returns
+// true.
+func (calls *calls) AssertCalledMatching(t TestingT, method string, match func(options interface{}) bool) bool {
+	t.Helper()
+	matching := calls.CallsTo(method)
+	for _, call := range matching {
+		if match(call.Options) {
+			return true
+		}
+	}
+	t.Errorf("expected %s to be called with matching options, but none of its %d calls matched", method, len(matching))
+	return false
+}
+
+// notStubbed returns the error of an operation without stub.
+func notStubbed(method string) error {
+	return fmt.Errorf("%w: %s", ErrNotStubbed, method)
+}
+
+// describe returns a readable representation of options, with the values of their pointer fields.
+func describe(options interface{}) string {
+	if encoded, err := json.Marshal(options); err == nil {
+		return fmt.Sprintf("%T%s", options, encoded)
+	}
+	return fmt.Sprintf("%#v", options)
+}
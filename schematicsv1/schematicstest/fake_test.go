@@ -0,0 +1,128 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicstest_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	"github.com/IBM/schematics-go-sdk/schematicsv1/schematicstest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// recordingT records the failures of assertions.
+type recordingT struct {
+	failures []string
+}
+
+func (t *recordingT) Helper() {}
+
+func (t *recordingT) Errorf(format string, args ...interface{}) {
+	t.failures = append(t.failures, fmt.Sprintf(format, args...))
+}
+
+var _ = Describe(`FakeSchematicsV1`, func() {
+	var fake *schematicstest.FakeSchematicsV1
+	var api schematicsv1.SchematicsV1API
+
+	BeforeEach(func() {
+		fake = &schematicstest.FakeSchematicsV1{}
+		api = fake
+	})
+
+	It(`Returns an error for operations without stub`, func() {
+		_, _, err := api.GetWorkspace(api.NewGetWorkspaceOptions("ws-1"))
+		Expect(errors.Is(err, schematicstest.ErrNotStubbed)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("GetWorkspace"))
+	})
+
+	It(`Calls the stubs of both variants of an operation`, func() {
+		fake.GetWorkspaceStub = func(ctx context.Context, options *schematicsv1.GetWorkspaceOptions) (*schematicsv1.WorkspaceResponse, *core.DetailedResponse, error) {
+			return &schematicsv1.WorkspaceResponse{ID: options.WID}, &core.DetailedResponse{StatusCode: 200}, nil
+		}
+		workspace, response, err := api.GetWorkspace(api.NewGetWorkspaceOptions("ws-1"))
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(200))
+		Expect(*workspace.ID).To(Equal("ws-1"))
+
+		type key struct{}
+		ctx := context.WithValue(context.Background(), key{}, "value")
+		workspace, _, err = api.GetWorkspaceWithContext(ctx, api.NewGetWorkspaceOptions("ws-2"))
+		Expect(err).To(BeNil())
+		Expect(*workspace.ID).To(Equal("ws-2"))
+
+		Expect(fake.GetWorkspaceCallCount()).To(Equal(2))
+		callCtx, options := fake.GetWorkspaceArgsForCall(1)
+		Expect(callCtx.Value(key{})).To(Equal("value"))
+		Expect(*options.WID).To(Equal("ws-2"))
+	})
+
+	It(`Stubs fixed return values`, func() {
+		stubErr := errors.New("conflict")
+		fake.DeleteJobReturns(nil, stubErr)
+		_, err := api.DeleteJob(api.NewDeleteJobOptions("job-1", "token"))
+		Expect(err).To(Equal(stubErr))
+	})
+
+	It(`Records the calls in order`, func() {
+		fake.ListJobsReturns(&schematicsv1.JobList{}, nil, nil)
+		fake.GetJobReturns(&schematicsv1.Job{}, nil, nil)
+		_, _, _ = api.ListJobs(api.NewListJobsOptions())
+		_, _, _ = api.GetJob(api.NewGetJobOptions("job-1"))
+
+		calls := fake.Calls()
+		Expect(calls).To(HaveLen(2))
+		Expect(calls[0].Method).To(Equal("ListJobs"))
+		Expect(calls[1].Method).To(Equal("GetJob"))
+		Expect(fake.CallsTo("GetJob")).To(HaveLen(1))
+
+		fake.ResetCalls()
+		Expect(fake.Calls()).To(BeEmpty())
+		_, _, err := api.GetJob(api.NewGetJobOptions("job-1"))
+		Expect(err).To(BeNil())
+	})
+
+	It(`Asserts the arguments of the calls`, func() {
+		fake.GetJobReturns(&schematicsv1.Job{}, nil, nil)
+		_, _, _ = api.GetJob(api.NewGetJobOptions("job-1"))
+
+		t := &recordingT{}
+		Expect(fake.AssertCalled(t, "GetJob")).To(BeTrue())
+		Expect(fake.AssertNumberOfCalls(t, "GetJob", 1)).To(BeTrue())
+		Expect(fake.AssertNotCalled(t, "DeleteJob")).To(BeTrue())
+		Expect(fake.AssertCalledWith(t, "GetJob", api.NewGetJobOptions("job-1"))).To(BeTrue())
+		Expect(fake.AssertCalledMatching(t, "GetJob", func(options interface{}) bool {
+			return *options.(*schematicsv1.GetJobOptions).JobID == "job-1"
+		})).To(BeTrue())
+		Expect(t.failures).To(BeEmpty())
+
+		Expect(fake.AssertCalledWith(t, "GetJob", api.NewGetJobOptions("job-2"))).To(BeFalse())
+		Expect(fake.AssertNotCalled(t, "GetJob")).To(BeFalse())
+		Expect(fake.AssertNumberOfCalls(t, "GetJob", 2)).To(BeFalse())
+		Expect(fake.AssertCalled(t, "DeleteJob")).To(BeFalse())
+		Expect(t.failures).To(HaveLen(4))
+		Expect(t.failures[0]).To(ContainSubstring("job-2"))
+	})
+
+	It(`Panics when the arguments of a missing call are requested`, func() {
+		Expect(func() { fake.GetJobArgsForCall(0) }).To(Panic())
+	})
+})
@@ -0,0 +1,771 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package schematicstest : An in-process fake of the Schematics service for unit testing
+package schematicstest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+)
+
+// Statuses of the workspaces managed by the fake server.
+const (
+	WorkspaceStatusDraft      = "DRAFT"
+	WorkspaceStatusInactive   = "INACTIVE"
+	WorkspaceStatusInProgress = "INPROGRESS"
+	WorkspaceStatusActive     = "ACTIVE"
+	WorkspaceStatusFailed     = "FAILED"
+)
+
+// Statuses of the workspace activities managed by the fake server.
+const (
+	ActivityStatusCreated    = "CREATED"
+	ActivityStatusInProgress = "IN PROGRESS"
+	ActivityStatusCompleted  = "COMPLETED"
+	ActivityStatusFailed     = "FAILED"
+)
+
+// Names of the workspace commands, as reported in the workspace activities.
+const (
+	CommandApply   = "APPLY"
+	CommandPlan    = "PLAN"
+	CommandRefresh = "REFRESH"
+	CommandDestroy = "DESTROY"
+)
+
+// headerNameOperationID is the header that carries the operationId of the requests sent by the instances
+// constructed with NewService.
+const headerNameOperationID = "X-Schematicstest-Operation"
+
+// ServerOptions : The options for NewServer.
+type ServerOptions struct {
+	// The location of the resources created by the server, used as the prefix of their IDs; "us-south" if empty.
+	Location string
+
+	// The function that decides whether a workspace command or a job fails. "command" is one of the Command*
+	// constants for workspace commands, or the command name of a job, and "resourceID" is the ID of the workspace
+	// or of the job. If nil, all commands and jobs succeed.
+	Fail func(command string, resourceID string) bool
+}
+
+// Fault : A fault injected into the requests handled by the server. The fields that select requests are combined;
+// empty fields select all requests.
+type Fault struct {
+	// Selects the requests of an operation by its operationId, e.g. "GetWorkspace".
+	OperationID string
+
+	// Selects the requests with an HTTP method.
+	Method string
+
+	// Selects the requests whose path starts with a prefix, e.g. "/v1/workspaces".
+	PathPrefix string
+
+	// The time to wait before handling the request.
+	Latency time.Duration
+
+	// The status code of the error response returned instead of handling the request. If 0, the request is handled
+	// normally after the latency.
+	StatusCode int
+
+	// The message of the error response; the status text of StatusCode if empty.
+	Message string
+
+	// The number of requests affected by the fault; 0 for all requests.
+	Count int
+}
+
+// Request : A request received by the server.
+type Request struct {
+	OperationID string
+	Method      string
+	Path        string
+}
+
+// Server : An in-process fake of the Schematics service, backed by an httptest.Server. It implements the
+// workspace, workspace activity, job, action, blueprint and inventory REST endpoints with in-memory state.
+//
+// Status transitions are driven by reads rather than by time, which keeps tests deterministic: each read of a
+// workspace, of one of its activities or of a job advances its pending transitions by one step.
+//
+//   - A workspace is created in the DRAFT status, and becomes INACTIVE on the next read.
+//   - A workspace command (apply, plan, refresh or destroy) locks the workspace and creates an activity in the
+//     CREATED status, which becomes IN PROGRESS and then COMPLETED or FAILED. When the command completes, the
+//     workspace is unlocked and an apply leaves it ACTIVE (or FAILED), a destroy INACTIVE. Each activity can also
+//     be read as a job, with the ID of the activity.
+//   - An update of the template repository of a workspace starts a pull after the next read, which locks the
+//     workspace in the IN PROGRESS status for one read.
+//   - A job is created in the job_pending status, which becomes job_in_progress and then job_finished or job_failed.
+type Server struct {
+	*httptest.Server
+
+	location string
+	fail     func(command string, resourceID string) bool
+
+	mutex       sync.Mutex
+	collections map[string]*collection
+	faults      []*Fault
+	requests    []Request
+	sequence    int
+}
+
+// collection holds the resources of a type, in the order of their creation.
+type collection struct {
+	prefix    string
+	resources map[string]*resource
+	order     []string
+}
+
+// plainText is a result that is written as text/plain rather than JSON.
+type plainText string
+
+// serviceError is an error result with the message ID reported by the service.
+type serviceError struct {
+	messageID string
+	message   string
+}
+
+// resource holds the JSON representation of a resource and the queue of its pending transitions, which may be
+// shared with other resources.
+type resource struct {
+	data  map[string]interface{}
+	queue *[]func()
+
+	// The activities of a workspace, in the order of their creation.
+	activities []*resource
+}
+
+// The collections of the server, with the infix of the IDs of their resources.
+var collectionPrefixes = map[string]string{
+	"workspaces":  "workspace",
+	"jobs":        "JOB",
+	"actions":     "ACTION",
+	"blueprints":  "BLUEPRINT",
+	"inventories": "INVENTORY",
+}
+
+// NewServer starts a fake Schematics server. The caller should call Close when finished, to shut it down.
+func NewServer(options *ServerOptions) *Server {
+	if options == nil {
+		options = &ServerOptions{}
+	}
+	server := &Server{
+		location:    options.Location,
+		fail:        options.Fail,
+		collections: make(map[string]*collection),
+	}
+	if server.location == "" {
+		server.location = "us-south"
+	}
+	for name, prefix := range collectionPrefixes {
+		server.collections[name] = &collection{prefix: prefix, resources: make(map[string]*resource)}
+	}
+	server.Server = httptest.NewServer(server)
+	return server
+}
+
+// NewService constructs a SchematicsV1 instance that sends its requests to the server, without authentication.
+// The server knows the operationId of the requests sent by such instances, which is used by Requests and to
+// select the requests affected by faults.
+func (server *Server) NewService() (*schematicsv1.SchematicsV1, error) {
+	service, err := schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err != nil {
+		return nil, err
+	}
+	service.Use(func(call *schematicsv1.Call, next schematicsv1.Handler) (*http.Response, error) {
+		call.Request.Header.Set(headerNameOperationID, call.OperationID)
+		return next(call)
+	})
+	return service, nil
+}
+
+// InjectFault injects a fault into the requests handled by the server. Faults are evaluated in the order in which
+// they were injected, and the first matching fault applies.
+func (server *Server) InjectFault(fault Fault) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.faults = append(server.faults, &fault)
+}
+
+// ClearFaults removes the injected faults.
+func (server *Server) ClearFaults() {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.faults = nil
+}
+
+// Requests returns the requests received by the server, in the order in which they were received.
+func (server *Server) Requests() []Request {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	return append([]Request{}, server.requests...)
+}
+
+// ServeHTTP handles a request to the fake service.
+func (server *Server) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	server.mutex.Lock()
+	server.requests = append(server.requests, Request{
+		OperationID: req.Header.Get(headerNameOperationID),
+		Method:      req.Method,
+		Path:        req.URL.Path,
+	})
+	fault := server.matchFault(req)
+	server.mutex.Unlock()
+
+	if fault != nil {
+		if fault.Latency > 0 {
+			select {
+			case <-time.After(fault.Latency):
+			case <-req.Context().Done():
+				return
+			}
+		}
+		if fault.StatusCode != 0 {
+			message := fault.Message
+			if message == "" {
+				message = http.StatusText(fault.StatusCode)
+			}
+			writeError(res, fault.StatusCode, "", message)
+			return
+		}
+	}
+
+	var body map[string]interface{}
+	if req.Body != nil && req.ContentLength != 0 {
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil && req.Header.Get("Content-Type") == "application/json" {
+			writeError(res, http.StatusBadRequest, "", "invalid JSON body: "+err.Error())
+			return
+		}
+	}
+
+	server.mutex.Lock()
+	statusCode, result := server.route(req, body)
+	server.mutex.Unlock()
+
+	if statusCode >= 400 {
+		if err, ok := result.(serviceError); ok {
+			writeError(res, statusCode, err.messageID, err.message)
+		} else {
+			writeError(res, statusCode, "", fmt.Sprint(result))
+		}
+		return
+	}
+	if text, ok := result.(plainText); ok {
+		res.Header().Set("Content-Type", "text/plain")
+		res.WriteHeader(statusCode)
+		fmt.Fprint(res, text)
+		return
+	}
+	res.Header().Set("Content-Type", "application/json")
+	res.WriteHeader(statusCode)
+	if result != nil {
+		_ = json.NewEncoder(res).Encode(result)
+	}
+}
+
+// matchFault returns the first fault that matches "req", if any. The caller must hold the mutex.
+func (server *Server) matchFault(req *http.Request) *Fault {
+	for i, fault := range server.faults {
+		if (fault.OperationID != "" && fault.OperationID != req.Header.Get(headerNameOperationID)) ||
+			(fault.Method != "" && fault.Method != req.Method) ||
+			!strings.HasPrefix(req.URL.Path, fault.PathPrefix) {
+			continue
+		}
+		matched := *fault
+		if fault.Count > 0 {
+			fault.Count--
+			if fault.Count == 0 {
+				server.faults = append(server.faults[:i:i], server.faults[i+1:]...)
+			}
+		}
+		return &matched
+	}
+	return nil
+}
+
+// writeError writes an error response in the format of the Schematics service, with the message ID "messageID"
+// or, if it is empty, one derived from the status code.
+func writeError(res http.ResponseWriter, statusCode int, messageID string, message string) {
+	if messageID == "" {
+		messageID = fmt.Sprintf("M%d", statusCode)
+	}
+	res.Header().Set("Content-Type", "application/json")
+	res.WriteHeader(statusCode)
+	_ = json.NewEncoder(res).Encode(map[string]interface{}{
+		"requestid":  fmt.Sprintf("fake-%d", time.Now().UnixNano()),
+		"messageid":  messageID,
+		"message":    message,
+		"statuscode": strconv.Itoa(statusCode),
+	})
+}
+
+// route handles a request and returns the status code and the result, or the error message for status codes of
+// 400 and above. The caller must hold the mutex.
+func (server *Server) route(req *http.Request, body map[string]interface{}) (int, interface{}) {
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	switch {
+	case len(segments) == 2 && segments[0] == "logs":
+		return server.activityLog(segments[1])
+	case len(segments) >= 2 && segments[0] == "v1" && segments[1] == "workspaces":
+		return server.routeWorkspaces(req, segments[2:], body)
+	case len(segments) >= 2 && segments[0] == "v2":
+		if _, ok := server.collections[segments[1]]; ok && segments[1] != "workspaces" {
+			return server.routeCollection(req, segments[1], segments[2:], body)
+		}
+	}
+	return http.StatusNotFound, fmt.Sprintf("path '%s' not found", req.URL.Path)
+}
+
+// routeWorkspaces handles the requests to /v1/workspaces.
+func (server *Server) routeWorkspaces(req *http.Request, segments []string, body map[string]interface{}) (int, interface{}) {
+	if len(segments) == 0 {
+		switch req.Method {
+		case http.MethodGet:
+			return server.list(req, "workspaces", "count", "workspaces")
+		case http.MethodPost:
+			return server.createWorkspace(body)
+		}
+		return http.StatusMethodNotAllowed, "method not allowed"
+	}
+
+	workspace, ok := server.collections["workspaces"].resources[segments[0]]
+	if !ok {
+		return http.StatusNotFound, fmt.Sprintf("workspace '%s' not found", segments[0])
+	}
+	switch {
+	case len(segments) == 1 && req.Method == http.MethodGet:
+		advance(workspace)
+		return http.StatusOK, workspace.data
+	case len(segments) == 1 && (req.Method == http.MethodPut || req.Method == http.MethodPatch):
+		if locked(workspace) {
+			return http.StatusConflict, lockedError(segments[0])
+		}
+		update(workspace, body)
+		if _, ok := body["template_repo"]; ok {
+			pullTemplate(workspace)
+		}
+		return http.StatusOK, workspace.data
+	case len(segments) == 1 && req.Method == http.MethodDelete:
+		if locked(workspace) {
+			return http.StatusConflict, lockedError(segments[0])
+		}
+		server.remove("workspaces", segments[0])
+		return http.StatusOK, "Workspace deleted"
+	case len(segments) == 2 && segments[1] == "apply" && req.Method == http.MethodPut:
+		return server.runCommand(workspace, CommandApply)
+	case len(segments) == 2 && segments[1] == "plan" && req.Method == http.MethodPost:
+		return server.runCommand(workspace, CommandPlan)
+	case len(segments) == 2 && segments[1] == "refresh" && req.Method == http.MethodPut:
+		return server.runCommand(workspace, CommandRefresh)
+	case len(segments) == 2 && segments[1] == "destroy" && req.Method == http.MethodDelete:
+		return server.runCommand(workspace, CommandDestroy)
+	case len(segments) == 2 && segments[1] == "actions" && req.Method == http.MethodGet:
+		advance(workspace)
+		actions := make([]interface{}, 0, len(workspace.activities))
+		for i := len(workspace.activities) - 1; i >= 0; i-- {
+			actions = append(actions, workspace.activities[i].data)
+		}
+		return http.StatusOK, map[string]interface{}{
+			"workspace_id":   workspace.data["id"],
+			"workspace_name": workspace.data["name"],
+			"actions":        actions,
+		}
+	case len(segments) >= 3 && segments[1] == "actions":
+		return server.routeActivity(req, workspace, segments[2:])
+	}
+	return http.StatusNotFound, fmt.Sprintf("path '%s' not found", req.URL.Path)
+}
+
+// routeActivity handles the requests to /v1/workspaces/{w_id}/actions/{activity_id}.
+func (server *Server) routeActivity(req *http.Request, workspace *resource, segments []string) (int, interface{}) {
+	var activity *resource
+	for _, candidate := range workspace.activities {
+		if candidate.data["action_id"] == segments[0] {
+			activity = candidate
+		}
+	}
+	if activity == nil {
+		return http.StatusNotFound, fmt.Sprintf("activity '%s' not found", segments[0])
+	}
+	switch {
+	case len(segments) == 1 && req.Method == http.MethodGet:
+		advance(activity)
+		return http.StatusOK, activity.data
+	case len(segments) == 1 && req.Method == http.MethodDelete:
+		// Stopping an activity completes it immediately.
+		*activity.queue = nil
+		activity.data["status"] = ActivityStatusFailed
+		activity.data["message"] = []interface{}{"The activity was stopped."}
+		server.completeCommand(workspace, activity, false)
+		return http.StatusOK, map[string]interface{}{"activityid": segments[0]}
+	case len(segments) == 2 && segments[1] == "logs" && req.Method == http.MethodGet:
+		advance(activity)
+		return http.StatusOK, map[string]interface{}{
+			"action_id": activity.data["action_id"],
+			"name":      activity.data["name"],
+			"templates": []interface{}{map[string]interface{}{
+				"template_id":   "template-1",
+				"template_type": "terraform_v1.5",
+				"log_url":       server.URL + "/logs/" + segments[0],
+			}},
+		}
+	}
+	return http.StatusNotFound, fmt.Sprintf("path '%s' not found", req.URL.Path)
+}
+
+// routeCollection handles the requests to the /v2 collections.
+func (server *Server) routeCollection(req *http.Request, name string, segments []string, body map[string]interface{}) (int, interface{}) {
+	if len(segments) == 0 {
+		switch req.Method {
+		case http.MethodGet:
+			return server.list(req, name, "total_count", name)
+		case http.MethodPost:
+			if name == "jobs" {
+				return server.createJob(body)
+			}
+			return http.StatusCreated, server.create(name, body).data
+		}
+		return http.StatusMethodNotAllowed, "method not allowed"
+	}
+
+	resource, ok := server.collections[name].resources[segments[0]]
+	if !ok {
+		return http.StatusNotFound, fmt.Sprintf("%s '%s' not found", collectionPrefixes[name], segments[0])
+	}
+	switch {
+	case len(segments) == 1 && req.Method == http.MethodGet:
+		advance(resource)
+		return http.StatusOK, resource.data
+	case len(segments) == 1 && (req.Method == http.MethodPut || req.Method == http.MethodPatch):
+		update(resource, body)
+		return http.StatusOK, resource.data
+	case len(segments) == 1 && req.Method == http.MethodDelete:
+		server.remove(name, segments[0])
+		return http.StatusNoContent, nil
+	case len(segments) == 2 && name == "jobs" && segments[1] == "logs" && req.Method == http.MethodGet:
+		advance(resource)
+		return http.StatusOK, map[string]interface{}{
+			"job_id":      resource.data["id"],
+			"job_name":    resource.data["name"],
+			"log_summary": resource.data["log_summary"],
+			"format":      "json",
+		}
+	case len(segments) == 2 && name == "jobs" && segments[1] == "files" && req.Method == http.MethodGet:
+		return http.StatusOK, map[string]interface{}{
+			"job_id":       resource.data["id"],
+			"job_name":     resource.data["name"],
+			"file_type":    req.URL.Query().Get("file_type"),
+			"file_content": "{}",
+			"updated_at":   now(),
+		}
+	}
+	return http.StatusNotFound, fmt.Sprintf("path '%s' not found", req.URL.Path)
+}
+
+// list returns a page of the resources of a collection, honouring the offset and limit query parameters.
+func (server *Server) list(req *http.Request, name string, countField string, itemsField string) (int, interface{}) {
+	collection := server.collections[name]
+	offset, _ := strconv.Atoi(req.URL.Query().Get("offset"))
+	limit, err := strconv.Atoi(req.URL.Query().Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = 100
+	}
+	items := []interface{}{}
+	for i := offset; i >= 0 && i < len(collection.order) && len(items) < limit; i++ {
+		items = append(items, collection.resources[collection.order[i]].data)
+	}
+	return http.StatusOK, map[string]interface{}{
+		countField: len(collection.order),
+		"offset":   offset,
+		"limit":    limit,
+		itemsField: items,
+	}
+}
+
+// create adds a resource to a collection.
+func (server *Server) create(name string, body map[string]interface{}) *resource {
+	collection := server.collections[name]
+	server.sequence++
+	id := fmt.Sprintf("%s.%s.%s.%08x", server.location, collection.prefix, idName(body["name"]), server.sequence)
+
+	data := make(map[string]interface{})
+	for field, value := range body {
+		data[field] = value
+	}
+	data["id"] = id
+	if name == "blueprints" {
+		// Blueprints report their ID in a field of their own.
+		data["blueprint_id"] = id
+	}
+	data["location"] = server.location
+	data["created_at"] = now()
+	data["created_by"] = "fake-user"
+
+	queue := []func(){}
+	resource := &resource{data: data, queue: &queue}
+	collection.resources[id] = resource
+	collection.order = append(collection.order, id)
+	return resource
+}
+
+// remove deletes a resource from a collection.
+func (server *Server) remove(name string, id string) {
+	collection := server.collections[name]
+	delete(collection.resources, id)
+	for i, candidate := range collection.order {
+		if candidate == id {
+			collection.order = append(collection.order[:i], collection.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// createWorkspace creates a workspace in the DRAFT status.
+func (server *Server) createWorkspace(body map[string]interface{}) (int, interface{}) {
+	workspace := server.create("workspaces", body)
+	workspace.data["status"] = WorkspaceStatusDraft
+	workspace.data["workspace_status"] = map[string]interface{}{"locked": false, "frozen": false}
+	*workspace.queue = append(*workspace.queue, func() {
+		workspace.data["status"] = WorkspaceStatusInactive
+	})
+	return http.StatusCreated, workspace.data
+}
+
+// runCommand starts a workspace command, which locks the workspace until its activity completes.
+func (server *Server) runCommand(workspace *resource, command string) (int, interface{}) {
+	id := workspace.data["id"].(string)
+	switch {
+	case locked(workspace):
+		return http.StatusConflict, lockedError(id)
+	case workspace.data["status"] == WorkspaceStatusDraft:
+		return http.StatusConflict, fmt.Sprintf("workspace '%s' is not ready", id)
+	}
+
+	server.sequence++
+	activityID := fmt.Sprintf("%08x%08x", server.sequence, time.Now().UnixNano()&0xffffffff)
+	activity := &resource{
+		data: map[string]interface{}{
+			"action_id":    activityID,
+			"name":         command,
+			"status":       ActivityStatusCreated,
+			"performed_at": now(),
+			"performed_by": "fake-user",
+			"message":      []interface{}{},
+		},
+		queue: workspace.queue,
+	}
+	workspace.activities = append(workspace.activities, activity)
+
+	// The activity can also be read as a job.
+	job := &resource{
+		data: map[string]interface{}{
+			"id":                activityID,
+			"name":              command,
+			"command_object":    "workspace",
+			"command_object_id": id,
+			"command_name":      "workspace_" + strings.ToLower(command),
+			"location":          server.location,
+			"submitted_at":      now(),
+			"status":            jobStatus("workspace_job_status", "job_pending"),
+			"log_summary": map[string]interface{}{
+				"job_id":   activityID,
+				"job_type": "workspace_job",
+				"workspace_job": map[string]interface{}{
+					"resources_add":     0,
+					"resources_modify":  0,
+					"resources_destroy": 0,
+				},
+			},
+		},
+		queue: workspace.queue,
+	}
+	jobs := server.collections["jobs"]
+	jobs.resources[activityID] = job
+	jobs.order = append(jobs.order, activityID)
+
+	previousStatus := workspace.data["status"]
+	workspace.data["status"] = WorkspaceStatusInProgress
+	workspace.data["workspace_status"] = map[string]interface{}{"locked": true, "locked_by": "fake-user", "locked_time": now(), "frozen": false}
+	failed := server.fail != nil && server.fail(command, id)
+
+	*workspace.queue = append(*workspace.queue,
+		func() {
+			activity.data["status"] = ActivityStatusInProgress
+			job.data["status"] = jobStatus("workspace_job_status", "job_in_progress")
+		},
+		func() {
+			if failed {
+				activity.data["status"] = ActivityStatusFailed
+				activity.data["message"] = []interface{}{fmt.Sprintf("The %s job failed.", strings.ToLower(command))}
+				job.data["status"] = jobStatus("workspace_job_status", "job_failed")
+			} else {
+				activity.data["status"] = ActivityStatusCompleted
+				job.data["status"] = jobStatus("workspace_job_status", "job_finished")
+			}
+			workspace.data["status"] = previousStatus
+			server.completeCommand(workspace, activity, !failed)
+		},
+	)
+	return http.StatusAccepted, map[string]interface{}{"activityid": activityID}
+}
+
+// completeCommand unlocks a workspace after the activity of a command has completed.
+func (server *Server) completeCommand(workspace *resource, activity *resource, succeeded bool) {
+	command := activity.data["name"]
+	switch {
+	case !succeeded && (command == CommandApply || command == CommandDestroy):
+		workspace.data["status"] = WorkspaceStatusFailed
+	case command == CommandApply:
+		workspace.data["status"] = WorkspaceStatusActive
+	case command == CommandDestroy:
+		workspace.data["status"] = WorkspaceStatusInactive
+	case workspace.data["status"] == WorkspaceStatusInProgress:
+		workspace.data["status"] = WorkspaceStatusInactive
+	}
+	workspace.data["workspace_status"] = map[string]interface{}{"locked": false, "frozen": false}
+	workspace.data["last_action_name"] = command
+	workspace.data["last_activity_id"] = activity.data["action_id"]
+}
+
+// createJob creates a job in the job_pending status.
+func (server *Server) createJob(body map[string]interface{}) (int, interface{}) {
+	job := server.create("jobs", body)
+	delete(job.data, "refresh_token")
+	job.data["name"] = body["command_name"]
+	job.data["submitted_at"] = now()
+
+	statusField := "action_job_status"
+	if body["command_object"] == "workspace" {
+		statusField = "workspace_job_status"
+	}
+	job.data["status"] = jobStatus(statusField, "job_pending")
+
+	command, _ := body["command_name"].(string)
+	failed := server.fail != nil && server.fail(command, job.data["id"].(string))
+	*job.queue = append(*job.queue,
+		func() {
+			job.data["status"] = jobStatus(statusField, "job_in_progress")
+		},
+		func() {
+			if failed {
+				job.data["status"] = jobStatus(statusField, "job_failed")
+			} else {
+				job.data["status"] = jobStatus(statusField, "job_finished")
+			}
+			job.data["end_at"] = now()
+		},
+	)
+	return http.StatusCreated, job.data
+}
+
+// activityLog returns the log of a workspace activity.
+func (server *Server) activityLog(activityID string) (int, interface{}) {
+	for _, workspace := range server.collections["workspaces"].resources {
+		for _, activity := range workspace.activities {
+			if activity.data["action_id"] == activityID {
+				return http.StatusOK, plainText(fmt.Sprintf("Activity %s: %s %s\n", activityID, activity.data["name"], activity.data["status"]))
+			}
+		}
+	}
+	return http.StatusNotFound, fmt.Sprintf("activity '%s' not found", activityID)
+}
+
+// advance applies the next pending transition of a resource, if any.
+func advance(resource *resource) {
+	if len(*resource.queue) > 0 {
+		step := (*resource.queue)[0]
+		*resource.queue = (*resource.queue)[1:]
+		step()
+	}
+}
+
+// update merges the fields of "body" into a resource, except its ID.
+func update(resource *resource, body map[string]interface{}) {
+	for field, value := range body {
+		if field != "id" {
+			resource.data[field] = value
+		}
+	}
+	resource.data["updated_at"] = now()
+}
+
+// pullTemplate queues the pull of the template repository of a workspace, which starts after the next read.
+func pullTemplate(workspace *resource) {
+	var previousStatus interface{}
+	*workspace.queue = append(*workspace.queue,
+		func() {},
+		func() {
+			previousStatus = workspace.data["status"]
+			workspace.data["status"] = WorkspaceStatusInProgress
+			workspace.data["workspace_status"] = map[string]interface{}{"locked": true, "locked_by": "fake-user", "locked_time": now(), "frozen": false}
+		},
+		func() {
+			workspace.data["status"] = previousStatus
+			workspace.data["workspace_status"] = map[string]interface{}{"locked": false, "frozen": false}
+			workspace.data["updated_at"] = now()
+		},
+	)
+}
+
+// lockedError returns the error reported for a request to the locked workspace "id".
+func lockedError(id string) serviceError {
+	return serviceError{messageID: schematicsv1.MessageIDWorkspaceLocked, message: fmt.Sprintf("workspace '%s' is locked", id)}
+}
+
+// locked returns true if a workspace is locked.
+func locked(workspace *resource) bool {
+	status, _ := workspace.data["workspace_status"].(map[string]interface{})
+	return status["locked"] == true
+}
+
+// jobStatus returns the status of a job, with the status code "statusCode" in the field "field".
+func jobStatus(field string, statusCode string) map[string]interface{} {
+	return map[string]interface{}{
+		field: map[string]interface{}{
+			"status_code": statusCode,
+			"updated_at":  now(),
+		},
+	}
+}
+
+// idNameRegexp matches the characters that are not allowed in the name part of an ID.
+var idNameRegexp = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+
+// idName returns the name part of the ID of a resource named "name".
+func idName(name interface{}) string {
+	if name, ok := name.(string); ok && name != "" {
+		return idNameRegexp.ReplaceAllString(name, "-")
+	}
+	return "resource"
+}
+
+// now returns the current time in the format of the service.
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,256 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicstest_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	"github.com/IBM/schematics-go-sdk/schematicsv1/schematicstest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Server`, func() {
+	var server *schematicstest.Server
+	var schematicsService *schematicsv1.SchematicsV1
+
+	newServer := func(options *schematicstest.ServerOptions) {
+		server = schematicstest.NewServer(options)
+		var err error
+		schematicsService, err = server.NewService()
+		Expect(err).To(BeNil())
+	}
+
+	createWorkspace := func(name string) *schematicsv1.WorkspaceResponse {
+		createWorkspaceOptions := schematicsService.NewCreateWorkspaceOptions()
+		createWorkspaceOptions.SetName(name)
+		workspace, _, err := schematicsService.CreateWorkspace(createWorkspaceOptions)
+		Expect(err).To(BeNil())
+		return workspace
+	}
+
+	getWorkspace := func(id string) *schematicsv1.WorkspaceResponse {
+		workspace, _, err := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions(id))
+		Expect(err).To(BeNil())
+		return workspace
+	}
+
+	getActivity := func(workspaceID string, activityID string) *schematicsv1.WorkspaceActivity {
+		activity, _, err := schematicsService.GetWorkspaceActivity(schematicsService.NewGetWorkspaceActivityOptions(workspaceID, activityID))
+		Expect(err).To(BeNil())
+		return activity
+	}
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe(`Workspaces`, func() {
+		It(`Moves a workspace from DRAFT to INACTIVE to ACTIVE`, func() {
+			newServer(nil)
+			workspace := createWorkspace("my workspace")
+			Expect(*workspace.ID).To(MatchRegexp(`^us-south\.workspace\.my-workspace\.`))
+			Expect(*workspace.Status).To(Equal(schematicstest.WorkspaceStatusDraft))
+			Expect(*getWorkspace(*workspace.ID).Status).To(Equal(schematicstest.WorkspaceStatusInactive))
+
+			apply, response, err := schematicsService.ApplyWorkspaceCommand(schematicsService.NewApplyWorkspaceCommandOptions(*workspace.ID, "token"))
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(http.StatusAccepted))
+
+			// The workspace is locked while the activity runs.
+			_, response, err = schematicsService.ApplyWorkspaceCommand(schematicsService.NewApplyWorkspaceCommandOptions(*workspace.ID, "token"))
+			Expect(err).ToNot(BeNil())
+			Expect(response.StatusCode).To(Equal(http.StatusConflict))
+			Expect(schematicsv1.IsConflict(err)).To(BeTrue())
+			Expect(schematicsv1.IsWorkspaceLocked(err)).To(BeTrue())
+
+			// Reads of the workspace and of its activity advance the same transitions.
+			workspace = getWorkspace(*workspace.ID)
+			Expect(*workspace.Status).To(Equal(schematicstest.WorkspaceStatusInProgress))
+			Expect(*workspace.WorkspaceStatus.Locked).To(BeTrue())
+			Expect(*getActivity(*workspace.ID, *apply.Activityid).Status).To(Equal(schematicstest.ActivityStatusCompleted))
+
+			workspace = getWorkspace(*workspace.ID)
+			Expect(*workspace.Status).To(Equal(schematicstest.WorkspaceStatusActive))
+			Expect(*workspace.WorkspaceStatus.Locked).To(BeFalse())
+			Expect(*workspace.LastActionName).To(Equal(schematicstest.CommandApply))
+			Expect(*workspace.LastActivityID).To(Equal(*apply.Activityid))
+
+			job, _, err := schematicsService.GetJob(schematicsService.NewGetJobOptions(*apply.Activityid))
+			Expect(err).To(BeNil())
+			Expect(*job.Status.WorkspaceJobStatus.StatusCode).To(Equal("job_finished"))
+		})
+
+		It(`Rejects commands on a DRAFT workspace`, func() {
+			newServer(nil)
+			workspace := createWorkspace("draft")
+			_, response, err := schematicsService.PlanWorkspaceCommand(schematicsService.NewPlanWorkspaceCommandOptions(*workspace.ID, "token"))
+			Expect(err).ToNot(BeNil())
+			Expect(response.StatusCode).To(Equal(http.StatusConflict))
+		})
+
+		It(`Fails the commands selected by the Fail option`, func() {
+			newServer(&schematicstest.ServerOptions{
+				Location: "eu-de",
+				Fail: func(command string, resourceID string) bool {
+					return command == schematicstest.CommandApply
+				},
+			})
+			workspace := createWorkspace("failing")
+			Expect(*workspace.ID).To(HavePrefix("eu-de.workspace."))
+			getWorkspace(*workspace.ID)
+
+			apply, _, err := schematicsService.ApplyWorkspaceCommand(schematicsService.NewApplyWorkspaceCommandOptions(*workspace.ID, "token"))
+			Expect(err).To(BeNil())
+			getActivity(*workspace.ID, *apply.Activityid)
+			activity := getActivity(*workspace.ID, *apply.Activityid)
+			Expect(*activity.Status).To(Equal(schematicstest.ActivityStatusFailed))
+			Expect(*getWorkspace(*workspace.ID).Status).To(Equal(schematicstest.WorkspaceStatusFailed))
+		})
+
+		It(`Lists and deletes workspaces`, func() {
+			newServer(nil)
+			first := createWorkspace("first")
+			createWorkspace("second")
+
+			listWorkspacesOptions := schematicsService.NewListWorkspacesOptions()
+			listWorkspacesOptions.SetLimit(1)
+			list, _, err := schematicsService.ListWorkspaces(listWorkspacesOptions)
+			Expect(err).To(BeNil())
+			Expect(*list.Count).To(Equal(int64(2)))
+			Expect(list.Workspaces).To(HaveLen(1))
+			Expect(*list.Workspaces[0].ID).To(Equal(*first.ID))
+
+			result, _, err := schematicsService.DeleteWorkspace(schematicsService.NewDeleteWorkspaceOptions("token", *first.ID))
+			Expect(err).To(BeNil())
+			Expect(*result).To(Equal("Workspace deleted"))
+
+			_, _, err = schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions(*first.ID))
+			Expect(schematicsv1.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Describe(`Jobs`, func() {
+		It(`Moves a job from pending to in progress to finished`, func() {
+			newServer(nil)
+			createJobOptions := schematicsService.NewCreateJobOptions("token")
+			createJobOptions.SetCommandObject("action")
+			createJobOptions.SetCommandObjectID("us-south.ACTION.action.1")
+			createJobOptions.SetCommandName("ansible_playbook_run")
+			job, response, err := schematicsService.CreateJob(createJobOptions)
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(http.StatusCreated))
+			Expect(*job.Status.ActionJobStatus.StatusCode).To(Equal("job_pending"))
+
+			for _, statusCode := range []string{"job_in_progress", "job_finished", "job_finished"} {
+				job, _, err = schematicsService.GetJob(schematicsService.NewGetJobOptions(*job.ID))
+				Expect(err).To(BeNil())
+				Expect(*job.Status.ActionJobStatus.StatusCode).To(Equal(statusCode))
+			}
+		})
+
+		It(`Fails the jobs selected by the Fail option`, func() {
+			newServer(&schematicstest.ServerOptions{
+				Fail: func(command string, resourceID string) bool {
+					return command == "ansible_playbook_check"
+				},
+			})
+			createJobOptions := schematicsService.NewCreateJobOptions("token")
+			createJobOptions.SetCommandObject("action")
+			createJobOptions.SetCommandName("ansible_playbook_check")
+			job, _, err := schematicsService.CreateJob(createJobOptions)
+			Expect(err).To(BeNil())
+
+			getJobOptions := schematicsService.NewGetJobOptions(*job.ID)
+			_, _, err = schematicsService.GetJob(getJobOptions)
+			Expect(err).To(BeNil())
+			job, _, err = schematicsService.GetJob(getJobOptions)
+			Expect(err).To(BeNil())
+			Expect(*job.Status.ActionJobStatus.StatusCode).To(Equal("job_failed"))
+		})
+	})
+
+	Describe(`Actions, blueprints and inventories`, func() {
+		It(`Stores actions, blueprints and inventories`, func() {
+			newServer(nil)
+			createActionOptions := schematicsService.NewCreateActionOptions()
+			createActionOptions.SetName("action")
+			action, _, err := schematicsService.CreateAction(createActionOptions)
+			Expect(err).To(BeNil())
+			Expect(*action.ID).To(HavePrefix("us-south.ACTION.action."))
+			action, _, err = schematicsService.GetAction(schematicsService.NewGetActionOptions(*action.ID))
+			Expect(err).To(BeNil())
+			Expect(*action.Name).To(Equal("action"))
+
+			blueprint, _, err := schematicsService.CreateBlueprint(schematicsService.NewCreateBlueprintOptions("blueprint"))
+			Expect(err).To(BeNil())
+			blueprint, _, err = schematicsService.GetBlueprint(schematicsService.NewGetBlueprintOptions(*blueprint.BlueprintID))
+			Expect(err).To(BeNil())
+			Expect(*blueprint.Name).To(Equal("blueprint"))
+
+			createInventoryOptions := schematicsService.NewCreateInventoryOptions()
+			createInventoryOptions.SetName("inventory")
+			_, _, err = schematicsService.CreateInventory(createInventoryOptions)
+			Expect(err).To(BeNil())
+			inventories, _, err := schematicsService.ListInventories(schematicsService.NewListInventoriesOptions())
+			Expect(err).To(BeNil())
+			Expect(*inventories.TotalCount).To(Equal(int64(1)))
+			Expect(*inventories.Inventories[0].Name).To(Equal("inventory"))
+		})
+	})
+
+	Describe(`Faults`, func() {
+		It(`Returns injected errors for the selected operation`, func() {
+			newServer(nil)
+			workspace := createWorkspace("faulty")
+			server.InjectFault(schematicstest.Fault{OperationID: "GetWorkspace", StatusCode: http.StatusServiceUnavailable, Count: 1})
+
+			_, response, err := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions(*workspace.ID))
+			Expect(err).ToNot(BeNil())
+			Expect(response.StatusCode).To(Equal(http.StatusServiceUnavailable))
+			Expect(schematicsv1.IsRetryable(err)).To(BeTrue())
+
+			// The fault applied to a single request.
+			getWorkspace(*workspace.ID)
+			Expect(server.Requests()).To(HaveLen(3))
+			Expect(server.Requests()[1].OperationID).To(Equal("GetWorkspace"))
+		})
+
+		It(`Delays requests and honours the context`, func() {
+			newServer(nil)
+			server.InjectFault(schematicstest.Fault{PathPrefix: "/v1/workspaces", Latency: time.Second})
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+			_, _, err := schematicsService.ListWorkspacesWithContext(ctx, schematicsService.NewListWorkspacesOptions())
+			Expect(err).ToNot(BeNil())
+
+			server.ClearFaults()
+			_, _, err = schematicsService.ListWorkspaces(schematicsService.NewListWorkspacesOptions())
+			Expect(err).To(BeNil())
+		})
+	})
+
+	It(`Requires no authentication`, func() {
+		newServer(nil)
+		Expect(schematicsService.Service.Options.Authenticator.AuthenticationType()).To(Equal(core.AUTHTYPE_NOAUTH))
+	})
+})
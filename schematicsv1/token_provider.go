@@ -0,0 +1,358 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultDelegatedTokenExpiry : The default lifetime of the delegated refresh tokens requested by an
+// IAMTokenProvider.
+const DefaultDelegatedTokenExpiry = time.Hour
+
+// DefaultIAMURL : The default URL of the IAM token service.
+const DefaultIAMURL = "https://iam.cloud.ibm.com"
+
+// tokenRenewalRatio is the fraction of the lifetime of a token after which an IAMTokenProvider renews it.
+const tokenRenewalRatio = 0.8
+
+// TokenProvider : The source of the IAM tokens sent in the refresh_token and delegated_token headers of the
+// operations that run Terraform or Ansible on behalf of the caller, such as ApplyWorkspaceCommand and CreateJob.
+// Implementations must be safe for concurrent use.
+type TokenProvider interface {
+	// RefreshToken returns an IAM refresh token.
+	RefreshToken(ctx context.Context) (string, error)
+
+	// DelegatedToken returns an IAM delegated refresh token, or an empty string if the provider does not supply
+	// delegated tokens.
+	DelegatedToken(ctx context.Context) (string, error)
+}
+
+// SetTokenProvider sets the provider of the refresh_token and delegated_token headers of the instance; nil removes
+// it. The provider is called by the operations whose options have no RefreshToken, or no DelegatedToken, before
+// the options are validated. The options of the caller are not modified. The provider is shared with the clones of
+// the instance made after the call.
+func (schematics *SchematicsV1) SetTokenProvider(provider TokenProvider) {
+	schematics.tokenProvider = provider
+}
+
+// EnableIAMTokenProvider sets an IAMTokenProvider backed by the IAM authenticator of the instance as its token
+// provider, and returns it. It fails if the authenticator of the instance is not a *core.IamAuthenticator.
+func (schematics *SchematicsV1) EnableIAMTokenProvider(options *IAMTokenProviderOptions) (*IAMTokenProvider, error) {
+	authenticator, ok := schematics.Service.Options.Authenticator.(*core.IamAuthenticator)
+	if !ok {
+		return nil, fmt.Errorf("the authenticator of the service is a %T, not a *core.IamAuthenticator", schematics.Service.Options.Authenticator)
+	}
+	provider, err := NewIAMTokenProvider(authenticator, options)
+	if err != nil {
+		return nil, err
+	}
+	schematics.SetTokenProvider(provider)
+	return provider, nil
+}
+
+// IAMTokenProviderOptions : The options for NewIAMTokenProvider.
+type IAMTokenProviderOptions struct {
+	// Whether the provider supplies delegated refresh tokens, which requires an authenticator with an API key.
+	DelegatedTokens bool
+
+	// The lifetime of the delegated refresh tokens; DefaultDelegatedTokenExpiry if zero.
+	DelegatedTokenExpiry time.Duration
+
+	// The IAM client IDs allowed to use the delegated refresh tokens; "schematics" if empty.
+	ReceiverClientIDs []string
+}
+
+// IAMTokenProvider : A TokenProvider that obtains the tokens from IAM with the credentials of an IAM authenticator.
+// The tokens are cached, and renewed once 80% of their lifetime has elapsed.
+type IAMTokenProvider struct {
+	authenticator        *core.IamAuthenticator
+	delegatedTokens      bool
+	delegatedTokenExpiry time.Duration
+	receiverClientIDs    []string
+	client               *http.Client
+	now                  func() time.Time
+
+	mutex                 sync.Mutex
+	refreshToken          string
+	refreshTokenRenewal   time.Time
+	delegatedToken        string
+	delegatedTokenRenewal time.Time
+}
+
+// NewIAMTokenProvider constructs an IAMTokenProvider that uses the credentials of "authenticator".
+func NewIAMTokenProvider(authenticator *core.IamAuthenticator, options *IAMTokenProviderOptions) (*IAMTokenProvider, error) {
+	if err := core.ValidateNotNil(authenticator, "authenticator cannot be nil"); err != nil {
+		return nil, err
+	}
+	if err := authenticator.Validate(); err != nil {
+		return nil, err
+	}
+	if options == nil {
+		options = &IAMTokenProviderOptions{}
+	}
+	if options.DelegatedTokens && authenticator.ApiKey == "" {
+		return nil, fmt.Errorf("delegated tokens require an authenticator with an API key")
+	}
+	provider := &IAMTokenProvider{
+		authenticator:        authenticator,
+		delegatedTokens:      options.DelegatedTokens,
+		delegatedTokenExpiry: options.DelegatedTokenExpiry,
+		receiverClientIDs:    options.ReceiverClientIDs,
+		client:               core.DefaultHTTPClient(),
+		now:                  time.Now,
+	}
+	if provider.delegatedTokenExpiry <= 0 {
+		provider.delegatedTokenExpiry = DefaultDelegatedTokenExpiry
+	}
+	if len(provider.receiverClientIDs) == 0 {
+		provider.receiverClientIDs = []string{"schematics"}
+	}
+	if authenticator.DisableSSLVerification {
+		transport := provider.client.Transport.(*http.Transport).Clone()
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} // #nosec G402
+		provider.client.Transport = transport
+	}
+	return provider, nil
+}
+
+// RefreshToken returns the cached IAM refresh token, requesting a new one if it is missing or about to expire.
+func (provider *IAMTokenProvider) RefreshToken(ctx context.Context) (string, error) {
+	provider.mutex.Lock()
+	defer provider.mutex.Unlock()
+	if provider.refreshToken != "" && provider.now().Before(provider.refreshTokenRenewal) {
+		return provider.refreshToken, nil
+	}
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	response, err := provider.authenticator.RequestToken()
+	if err != nil {
+		return "", err
+	}
+	if response.RefreshToken == "" {
+		return "", fmt.Errorf("the IAM token service returned no refresh token")
+	}
+	provider.refreshToken = response.RefreshToken
+	provider.refreshTokenRenewal = provider.now().Add(time.Duration(float64(response.ExpiresIn)*tokenRenewalRatio) * time.Second)
+	return provider.refreshToken, nil
+}
+
+// DelegatedToken returns the cached IAM delegated refresh token, requesting a new one if it is missing or about to
+// expire. It returns an empty string if the provider was not configured for delegated tokens.
+func (provider *IAMTokenProvider) DelegatedToken(ctx context.Context) (string, error) {
+	if !provider.delegatedTokens {
+		return "", nil
+	}
+	provider.mutex.Lock()
+	defer provider.mutex.Unlock()
+	if provider.delegatedToken != "" && provider.now().Before(provider.delegatedTokenRenewal) {
+		return provider.delegatedToken, nil
+	}
+
+	form := url.Values{
+		"grant_type":                     {"urn:ibm:params:oauth:grant-type:apikey"},
+		"apikey":                         {provider.authenticator.ApiKey},
+		"response_type":                  {"delegated_refresh_token"},
+		"receiver_client_ids":            {strings.Join(provider.receiverClientIDs, ",")},
+		"delegated_refresh_token_expiry": {strconv.Itoa(int(provider.delegatedTokenExpiry.Seconds()))},
+	}
+	iamURL := provider.authenticator.URL
+	if iamURL == "" {
+		iamURL = DefaultIAMURL
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(iamURL, "/")+"/identity/token", strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	for name, value := range provider.authenticator.Headers {
+		req.Header.Set(name, value)
+	}
+
+	response, err := provider.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return "", fmt.Errorf("unexpected status code %d received from the IAM token service", response.StatusCode)
+	}
+	var body struct {
+		DelegatedRefreshToken string `json:"delegated_refresh_token"`
+	}
+	if err := json.NewDecoder(response.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("error decoding the response of the IAM token service: %w", err)
+	}
+	if body.DelegatedRefreshToken == "" {
+		return "", fmt.Errorf("the IAM token service returned no delegated refresh token")
+	}
+	provider.delegatedToken = body.DelegatedRefreshToken
+	provider.delegatedTokenRenewal = provider.now().Add(time.Duration(float64(provider.delegatedTokenExpiry) * tokenRenewalRatio))
+	return provider.delegatedToken, nil
+}
+
+// provideRefreshToken returns "token" if it is set, or a refresh token of "provider".
+func provideRefreshToken(ctx context.Context, provider TokenProvider, token *string) (*string, error) {
+	if token != nil && *token != "" {
+		return token, nil
+	}
+	refreshToken, err := provider.RefreshToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to obtain a refresh token: %w", err)
+	}
+	return &refreshToken, nil
+}
+
+// provideDelegatedToken returns "token" if it is set, or a delegated token of "provider", if it supplies them.
+func provideDelegatedToken(ctx context.Context, provider TokenProvider, token *string) (*string, error) {
+	if token != nil && *token != "" {
+		return token, nil
+	}
+	delegatedToken, err := provider.DelegatedToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to obtain a delegated token: %w", err)
+	}
+	if delegatedToken == "" {
+		return token, nil
+	}
+	return &delegatedToken, nil
+}
+
+// withProvidedTokens returns a copy of the options with the tokens that are not set supplied by "provider".
+func (options *ApplyWorkspaceCommandOptions) withProvidedTokens(ctx context.Context, provider TokenProvider) (*ApplyWorkspaceCommandOptions, error) {
+	copied := *options
+	var err error
+	if copied.RefreshToken, err = provideRefreshToken(ctx, provider, copied.RefreshToken); err != nil {
+		return nil, err
+	}
+	if copied.DelegatedToken, err = provideDelegatedToken(ctx, provider, copied.DelegatedToken); err != nil {
+		return nil, err
+	}
+	return &copied, nil
+}
+
+// withProvidedTokens returns a copy of the options with the tokens that are not set supplied by "provider".
+func (options *CreateJobOptions) withProvidedTokens(ctx context.Context, provider TokenProvider) (*CreateJobOptions, error) {
+	copied := *options
+	var err error
+	if copied.RefreshToken, err = provideRefreshToken(ctx, provider, copied.RefreshToken); err != nil {
+		return nil, err
+	}
+	return &copied, nil
+}
+
+// withProvidedTokens returns a copy of the options with the tokens that are not set supplied by "provider".
+func (options *CreateWorkspaceDeletionJobOptions) withProvidedTokens(ctx context.Context, provider TokenProvider) (*CreateWorkspaceDeletionJobOptions, error) {
+	copied := *options
+	var err error
+	if copied.RefreshToken, err = provideRefreshToken(ctx, provider, copied.RefreshToken); err != nil {
+		return nil, err
+	}
+	return &copied, nil
+}
+
+// withProvidedTokens returns a copy of the options with the tokens that are not set supplied by "provider".
+func (options *DeleteJobOptions) withProvidedTokens(ctx context.Context, provider TokenProvider) (*DeleteJobOptions, error) {
+	copied := *options
+	var err error
+	if copied.RefreshToken, err = provideRefreshToken(ctx, provider, copied.RefreshToken); err != nil {
+		return nil, err
+	}
+	return &copied, nil
+}
+
+// withProvidedTokens returns a copy of the options with the tokens that are not set supplied by "provider".
+func (options *DeleteWorkspaceOptions) withProvidedTokens(ctx context.Context, provider TokenProvider) (*DeleteWorkspaceOptions, error) {
+	copied := *options
+	var err error
+	if copied.RefreshToken, err = provideRefreshToken(ctx, provider, copied.RefreshToken); err != nil {
+		return nil, err
+	}
+	return &copied, nil
+}
+
+// withProvidedTokens returns a copy of the options with the tokens that are not set supplied by "provider".
+func (options *DestroyWorkspaceCommandOptions) withProvidedTokens(ctx context.Context, provider TokenProvider) (*DestroyWorkspaceCommandOptions, error) {
+	copied := *options
+	var err error
+	if copied.RefreshToken, err = provideRefreshToken(ctx, provider, copied.RefreshToken); err != nil {
+		return nil, err
+	}
+	if copied.DelegatedToken, err = provideDelegatedToken(ctx, provider, copied.DelegatedToken); err != nil {
+		return nil, err
+	}
+	return &copied, nil
+}
+
+// withProvidedTokens returns a copy of the options with the tokens that are not set supplied by "provider".
+func (options *PlanWorkspaceCommandOptions) withProvidedTokens(ctx context.Context, provider TokenProvider) (*PlanWorkspaceCommandOptions, error) {
+	copied := *options
+	var err error
+	if copied.RefreshToken, err = provideRefreshToken(ctx, provider, copied.RefreshToken); err != nil {
+		return nil, err
+	}
+	if copied.DelegatedToken, err = provideDelegatedToken(ctx, provider, copied.DelegatedToken); err != nil {
+		return nil, err
+	}
+	return &copied, nil
+}
+
+// withProvidedTokens returns a copy of the options with the tokens that are not set supplied by "provider".
+func (options *RefreshWorkspaceCommandOptions) withProvidedTokens(ctx context.Context, provider TokenProvider) (*RefreshWorkspaceCommandOptions, error) {
+	copied := *options
+	var err error
+	if copied.RefreshToken, err = provideRefreshToken(ctx, provider, copied.RefreshToken); err != nil {
+		return nil, err
+	}
+	if copied.DelegatedToken, err = provideDelegatedToken(ctx, provider, copied.DelegatedToken); err != nil {
+		return nil, err
+	}
+	return &copied, nil
+}
+
+// withProvidedTokens returns a copy of the options with the tokens that are not set supplied by "provider".
+func (options *RunWorkspaceCommandsOptions) withProvidedTokens(ctx context.Context, provider TokenProvider) (*RunWorkspaceCommandsOptions, error) {
+	copied := *options
+	var err error
+	if copied.RefreshToken, err = provideRefreshToken(ctx, provider, copied.RefreshToken); err != nil {
+		return nil, err
+	}
+	return &copied, nil
+}
+
+// withProvidedTokens returns a copy of the options with the tokens that are not set supplied by "provider".
+func (options *UpdateJobOptions) withProvidedTokens(ctx context.Context, provider TokenProvider) (*UpdateJobOptions, error) {
+	copied := *options
+	var err error
+	if copied.RefreshToken, err = provideRefreshToken(ctx, provider, copied.RefreshToken); err != nil {
+		return nil, err
+	}
+	return &copied, nil
+}
@@ -0,0 +1,218 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// staticTokenProvider supplies fixed tokens.
+type staticTokenProvider struct {
+	refreshToken   string
+	delegatedToken string
+	err            error
+}
+
+func (provider *staticTokenProvider) RefreshToken(ctx context.Context) (string, error) {
+	return provider.refreshToken, provider.err
+}
+
+func (provider *staticTokenProvider) DelegatedToken(ctx context.Context) (string, error) {
+	return provider.delegatedToken, provider.err
+}
+
+var _ = Describe(`TokenProvider`, func() {
+	var testServer *httptest.Server
+	var schematicsService *schematicsv1.SchematicsV1
+	var requests []*http.Request
+
+	BeforeEach(func() {
+		requests = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			requests = append(requests, req)
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(202)
+			_, _ = res.Write([]byte(`{"activityid": "activity-1"}`))
+		}))
+
+		var serviceErr error
+		schematicsService, serviceErr = schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Supplies the tokens that are not set`, func() {
+		schematicsService.SetTokenProvider(&staticTokenProvider{refreshToken: "provided-refresh", delegatedToken: "provided-delegated"})
+
+		applyWorkspaceCommandOptions := &schematicsv1.ApplyWorkspaceCommandOptions{WID: core.StringPtr("ws-1")}
+		_, _, err := schematicsService.ApplyWorkspaceCommand(applyWorkspaceCommandOptions)
+		Expect(err).To(BeNil())
+		Expect(requests[0].Header["Refresh_token"]).To(Equal([]string{"provided-refresh"}))
+		Expect(requests[0].Header["Delegated_token"]).To(Equal([]string{"provided-delegated"}))
+		Expect(applyWorkspaceCommandOptions.RefreshToken).To(BeNil())
+
+		// Tokens set by the caller are kept.
+		createJobOptions := schematicsService.NewCreateJobOptions("caller-refresh")
+		_, _, err = schematicsService.CreateJob(createJobOptions)
+		Expect(err).To(BeNil())
+		Expect(requests[1].Header["Refresh_token"]).To(Equal([]string{"caller-refresh"}))
+
+		// Empty tokens are supplied as well.
+		_, err = schematicsService.DeleteJob(schematicsService.NewDeleteJobOptions("job-1", ""))
+		Expect(err).To(BeNil())
+		Expect(requests[2].Header["Refresh_token"]).To(Equal([]string{"provided-refresh"}))
+	})
+
+	It(`Omits the delegated token when the provider supplies none`, func() {
+		schematicsService.SetTokenProvider(&staticTokenProvider{refreshToken: "provided-refresh"})
+		_, _, err := schematicsService.PlanWorkspaceCommand(&schematicsv1.PlanWorkspaceCommandOptions{WID: core.StringPtr("ws-1")})
+		Expect(err).To(BeNil())
+		Expect(requests[0].Header["Refresh_token"]).To(Equal([]string{"provided-refresh"}))
+		Expect(requests[0].Header).ToNot(HaveKey("Delegated_token"))
+	})
+
+	It(`Fails the operation when the provider fails`, func() {
+		providerErr := errors.New("IAM unavailable")
+		schematicsService.SetTokenProvider(&staticTokenProvider{err: providerErr})
+		_, _, err := schematicsService.DestroyWorkspaceCommand(&schematicsv1.DestroyWorkspaceCommandOptions{WID: core.StringPtr("ws-1")})
+		Expect(errors.Is(err, providerErr)).To(BeTrue())
+		Expect(requests).To(BeEmpty())
+
+		schematicsService.SetTokenProvider(nil)
+		_, _, err = schematicsService.DestroyWorkspaceCommand(&schematicsv1.DestroyWorkspaceCommandOptions{WID: core.StringPtr("ws-1")})
+		Expect(err).To(MatchError(ContainSubstring("RefreshToken")))
+	})
+})
+
+var _ = Describe(`IAMTokenProvider`, func() {
+	var iamServer *httptest.Server
+	var mutex sync.Mutex
+	var forms []map[string]string
+	var expiresIn int
+
+	BeforeEach(func() {
+		forms = nil
+		expiresIn = 3600
+		iamServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			Expect(req.URL.Path).To(Equal("/identity/token"))
+			Expect(req.ParseForm()).To(Succeed())
+			form := make(map[string]string)
+			for name := range req.PostForm {
+				form[name] = req.PostForm.Get(name)
+			}
+			mutex.Lock()
+			forms = append(forms, form)
+			count := len(forms)
+			mutex.Unlock()
+
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			if form["response_type"] == "delegated_refresh_token" {
+				fmt.Fprintf(res, `{"delegated_refresh_token": "delegated-%d"}`, count)
+				return
+			}
+			fmt.Fprintf(res, `{"access_token": "access-%d", "refresh_token": "refresh-%d", "token_type": "Bearer", "expires_in": %d, "expiration": 0}`, count, count, expiresIn)
+		}))
+	})
+	AfterEach(func() {
+		iamServer.Close()
+	})
+
+	newAuthenticator := func() *core.IamAuthenticator {
+		return &core.IamAuthenticator{ApiKey: "api-key", URL: iamServer.URL}
+	}
+
+	It(`Caches the refresh token`, func() {
+		provider, err := schematicsv1.NewIAMTokenProvider(newAuthenticator(), nil)
+		Expect(err).To(BeNil())
+		token, err := provider.RefreshToken(context.Background())
+		Expect(err).To(BeNil())
+		Expect(token).To(Equal("refresh-1"))
+		token, err = provider.RefreshToken(context.Background())
+		Expect(err).To(BeNil())
+		Expect(token).To(Equal("refresh-1"))
+		Expect(forms).To(HaveLen(1))
+		Expect(forms[0]["apikey"]).To(Equal("api-key"))
+
+		token, err = provider.DelegatedToken(context.Background())
+		Expect(err).To(BeNil())
+		Expect(token).To(BeEmpty())
+		Expect(forms).To(HaveLen(1))
+	})
+
+	It(`Renews expired refresh tokens`, func() {
+		expiresIn = 0
+		provider, err := schematicsv1.NewIAMTokenProvider(newAuthenticator(), nil)
+		Expect(err).To(BeNil())
+		_, err = provider.RefreshToken(context.Background())
+		Expect(err).To(BeNil())
+		token, err := provider.RefreshToken(context.Background())
+		Expect(err).To(BeNil())
+		Expect(token).To(Equal("refresh-2"))
+	})
+
+	It(`Requests and caches delegated tokens`, func() {
+		provider, err := schematicsv1.NewIAMTokenProvider(newAuthenticator(), &schematicsv1.IAMTokenProviderOptions{DelegatedTokens: true})
+		Expect(err).To(BeNil())
+		token, err := provider.DelegatedToken(context.Background())
+		Expect(err).To(BeNil())
+		Expect(token).To(Equal("delegated-1"))
+		token, err = provider.DelegatedToken(context.Background())
+		Expect(err).To(BeNil())
+		Expect(token).To(Equal("delegated-1"))
+		Expect(forms).To(HaveLen(1))
+		Expect(forms[0]["receiver_client_ids"]).To(Equal("schematics"))
+		Expect(forms[0]["delegated_refresh_token_expiry"]).To(Equal("3600"))
+		Expect(forms[0]["grant_type"]).To(Equal("urn:ibm:params:oauth:grant-type:apikey"))
+	})
+
+	It(`Is enabled from the IAM authenticator of the service`, func() {
+		schematicsService, serviceErr := schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			URL:           "https://schematics.example.com",
+			Authenticator: newAuthenticator(),
+		})
+		Expect(serviceErr).To(BeNil())
+		provider, err := schematicsService.EnableIAMTokenProvider(nil)
+		Expect(err).To(BeNil())
+		Expect(provider).ToNot(BeNil())
+
+		schematicsService.Service.Options.Authenticator = &core.NoAuthAuthenticator{}
+		_, err = schematicsService.EnableIAMTokenProvider(nil)
+		Expect(err).ToNot(BeNil())
+	})
+
+	It(`Requires an API key for delegated tokens`, func() {
+		authenticator := &core.IamAuthenticator{RefreshToken: "refresh", ClientId: "id", ClientSecret: "secret", URL: iamServer.URL}
+		_, err := schematicsv1.NewIAMTokenProvider(authenticator, &schematicsv1.IAMTokenProviderOptions{DelegatedTokens: true})
+		Expect(err).ToNot(BeNil())
+	})
+})
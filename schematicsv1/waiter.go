@@ -0,0 +1,676 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/schematics-go-sdk/common"
+)
+
+// Default settings of the waiters.
+const (
+	DefaultWaitTimeout     = 30 * time.Minute
+	DefaultWaitMinInterval = 2 * time.Second
+	DefaultWaitMaxInterval = 30 * time.Second
+	DefaultWaitMultiplier  = 2.0
+	DefaultWaitJitter      = 0.2
+)
+
+// The statuses of a workspace, as reported in the Status of a WorkspaceResponse. Statuses are compared
+// case-insensitively and ignoring spaces and underscores, so that "In progress" matches WorkspaceStatusInProgress.
+const (
+	WorkspaceStatusActive        = "ACTIVE"
+	WorkspaceStatusConnecting    = "CONNECTING"
+	WorkspaceStatusDraft         = "DRAFT"
+	WorkspaceStatusFailed        = "FAILED"
+	WorkspaceStatusInactive      = "INACTIVE"
+	WorkspaceStatusInProgress    = "INPROGRESS"
+	WorkspaceStatusScanning      = "SCANNING"
+	WorkspaceStatusStopped       = "STOPPED"
+	WorkspaceStatusTemplateError = "TEMPLATE ERROR"
+)
+
+// WorkspacePendingStatuses are the statuses of a workspace that is being created, updated or running a job.
+// WaitForWorkspaceStatus waits for the workspace to leave them if no target status is given.
+var WorkspacePendingStatuses = []string{
+	WorkspaceStatusDraft,
+	WorkspaceStatusConnecting,
+	WorkspaceStatusScanning,
+	WorkspaceStatusInProgress,
+}
+
+// The statuses of a workspace activity, as reported in the Status of a WorkspaceActivity.
+const (
+	ActivityStatusCreated    = "CREATED"
+	ActivityStatusInProgress = "IN PROGRESS"
+	ActivityStatusCompleted  = "COMPLETED"
+	ActivityStatusFailed     = "FAILED"
+	ActivityStatusStopped    = "STOPPED"
+)
+
+// ActivityTerminalStatuses are the statuses of a workspace activity that has ended. WaitForWorkspaceActivity
+// waits for the activity to reach one of them.
+var ActivityTerminalStatuses = []string{
+	ActivityStatusCompleted,
+	ActivityStatusFailed,
+	ActivityStatusStopped,
+}
+
+// ErrWaitTimeout is matched by the errors returned by the waiters when their timeout expires.
+var ErrWaitTimeout = errors.New("timed out waiting")
+
+// WaitBackoff : The polling schedule of a waiter. The interval between two polls starts at MinInterval and is
+// multiplied by Multiplier after each poll, up to MaxInterval, and each interval is randomly lengthened or
+// shortened by up to the Jitter fraction of it, so that concurrent waiters do not poll in lockstep.
+type WaitBackoff struct {
+	// The interval before the second poll; DefaultWaitMinInterval if 0.
+	MinInterval time.Duration
+
+	// The maximum interval between two polls; DefaultWaitMaxInterval if 0.
+	MaxInterval time.Duration
+
+	// The factor applied to the interval after each poll; DefaultWaitMultiplier if 0.
+	Multiplier float64
+
+	// The fraction of each interval that is randomized; DefaultWaitJitter if 0, no jitter if negative.
+	Jitter float64
+}
+
+// interval returns the interval after the poll "attempt", counted from 0.
+func (backoff *WaitBackoff) interval(attempt int) time.Duration {
+	minInterval, maxInterval, multiplier, jitter := DefaultWaitMinInterval, DefaultWaitMaxInterval, DefaultWaitMultiplier, DefaultWaitJitter
+	if backoff != nil {
+		if backoff.MinInterval > 0 {
+			minInterval = backoff.MinInterval
+		}
+		if backoff.MaxInterval > 0 {
+			maxInterval = backoff.MaxInterval
+		}
+		if backoff.Multiplier > 0 {
+			multiplier = backoff.Multiplier
+		}
+		if backoff.Jitter != 0 {
+			jitter = backoff.Jitter
+		}
+	}
+
+	interval := float64(minInterval)
+	for i := 0; i < attempt && interval < float64(maxInterval); i++ {
+		interval *= multiplier
+	}
+	interval = min(interval, float64(maxInterval))
+	if jitter > 0 {
+		interval *= 1 + jitter*(2*rand.Float64()-1)
+	}
+	return time.Duration(interval)
+}
+
+// errWaitTimeout is returned by waitFor when its timeout expires.
+var errWaitTimeout = errors.New("wait timeout")
+
+// waitFor calls "poll" until it returns true or an error, sleeping between the calls as specified by "backoff",
+// for at most "timeout" (DefaultWaitTimeout if 0). Errors for which IsRetryable returns true do not end the
+// wait. It returns errWaitTimeout when the timeout expires, and the error of "ctx" when it is done.
+func waitFor(ctx context.Context, timeout time.Duration, backoff *WaitBackoff, poll func(ctx context.Context, attempt int) (bool, error)) error {
+	if timeout <= 0 {
+		timeout = DefaultWaitTimeout
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for attempt := 0; ; attempt++ {
+		done, err := poll(waitCtx, attempt)
+		switch {
+		case ctx.Err() != nil:
+			return ctx.Err()
+		case waitCtx.Err() != nil:
+			return errWaitTimeout
+		case err != nil && !IsRetryable(err):
+			return err
+		case err == nil && done:
+			return nil
+		}
+
+		timer := time.NewTimer(backoff.interval(attempt))
+		select {
+		case <-timer.C:
+		case <-waitCtx.Done():
+			timer.Stop()
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return errWaitTimeout
+		}
+	}
+}
+
+// normalizeStatus returns a status in upper case, without spaces and underscores.
+func normalizeStatus(status string) string {
+	return strings.NewReplacer(" ", "", "_", "").Replace(strings.ToUpper(status))
+}
+
+// containsStatus returns true if "statuses" contains "status", compared with normalizeStatus.
+func containsStatus(statuses []string, status string) bool {
+	for _, candidate := range statuses {
+		if normalizeStatus(candidate) == normalizeStatus(status) {
+			return true
+		}
+	}
+	return false
+}
+
+// WaitForWorkspaceStatusOptions : The options for WaitForWorkspaceStatus.
+type WaitForWorkspaceStatusOptions struct {
+	// The ID of the workspace.
+	WID *string `validate:"required,ne="`
+
+	// The statuses to wait for, such as WorkspaceStatusInactive. If empty, the wait ends when the status of the
+	// workspace is none of the WorkspacePendingStatuses.
+	Statuses []string
+
+	// If true, the wait also requires the workspace to be unlocked, as reported by its WorkspaceStatus.
+	Unlocked bool
+
+	// The maximum duration of the wait; DefaultWaitTimeout if 0.
+	Timeout time.Duration
+
+	// The polling schedule; the defaults of WaitBackoff if nil.
+	Backoff *WaitBackoff
+
+	// The function called after each poll that did not end the wait.
+	OnProgress func(progress *WorkspaceWaitProgress)
+}
+
+// NewWaitForWorkspaceStatusOptions : Instantiate WaitForWorkspaceStatusOptions
+func (*SchematicsV1) NewWaitForWorkspaceStatusOptions(wID string, statuses ...string) *WaitForWorkspaceStatusOptions {
+	return &WaitForWorkspaceStatusOptions{
+		WID:      core.StringPtr(wID),
+		Statuses: statuses,
+	}
+}
+
+// SetUnlocked : Allow user to set Unlocked
+func (_options *WaitForWorkspaceStatusOptions) SetUnlocked(unlocked bool) *WaitForWorkspaceStatusOptions {
+	_options.Unlocked = unlocked
+	return _options
+}
+
+// SetTimeout : Allow user to set Timeout
+func (_options *WaitForWorkspaceStatusOptions) SetTimeout(timeout time.Duration) *WaitForWorkspaceStatusOptions {
+	_options.Timeout = timeout
+	return _options
+}
+
+// SetBackoff : Allow user to set Backoff
+func (_options *WaitForWorkspaceStatusOptions) SetBackoff(backoff *WaitBackoff) *WaitForWorkspaceStatusOptions {
+	_options.Backoff = backoff
+	return _options
+}
+
+// SetOnProgress : Allow user to set OnProgress
+func (_options *WaitForWorkspaceStatusOptions) SetOnProgress(onProgress func(progress *WorkspaceWaitProgress)) *WaitForWorkspaceStatusOptions {
+	_options.OnProgress = onProgress
+	return _options
+}
+
+// WorkspaceWaitProgress : The state of a WaitForWorkspaceStatus after a poll that did not end it.
+type WorkspaceWaitProgress struct {
+	// The number of polls so far.
+	Polls int
+
+	// The time since the wait started.
+	Elapsed time.Duration
+
+	// The workspace returned by the poll, or nil if it failed.
+	Workspace *WorkspaceResponse
+
+	// The retryable error of the poll, if it failed.
+	Err error
+}
+
+// WorkspaceWaitTimeoutError : The error returned by WaitForWorkspaceStatus when its timeout expires. Use
+// errors.Is(err, ErrWaitTimeout) to detect it.
+type WorkspaceWaitTimeoutError struct {
+	WID      string
+	Statuses []string
+	Unlocked bool
+
+	// The duration of the wait and the number of polls.
+	Elapsed time.Duration
+	Polls   int
+
+	// The workspace returned by the last successful poll, or nil if none succeeded.
+	Workspace *WorkspaceResponse
+
+	// The error of the last poll, if it failed.
+	LastErr error
+}
+
+func (err *WorkspaceWaitTimeoutError) Error() string {
+	var state string
+	switch {
+	case err.Workspace == nil:
+		state = "the workspace was never read"
+	case workspaceLocked(err.Workspace):
+		state = fmt.Sprintf("last status '%s', locked", core.StringNilMapper(err.Workspace.Status))
+	default:
+		state = fmt.Sprintf("last status '%s'", core.StringNilMapper(err.Workspace.Status))
+	}
+	if err.LastErr != nil {
+		state += fmt.Sprintf(", last error: %s", err.LastErr.Error())
+	}
+	return fmt.Sprintf("%s for workspace '%s' after %s (%s)", ErrWaitTimeout.Error(), err.WID, err.Elapsed.Round(time.Millisecond), state)
+}
+
+// Is returns true if "target" is ErrWaitTimeout.
+func (err *WorkspaceWaitTimeoutError) Is(target error) bool {
+	return target == ErrWaitTimeout
+}
+
+// workspaceLocked returns true if a workspace is locked.
+func workspaceLocked(workspace *WorkspaceResponse) bool {
+	return workspace.WorkspaceStatus != nil && workspace.WorkspaceStatus.Locked != nil && *workspace.WorkspaceStatus.Locked
+}
+
+// WaitForWorkspaceStatus : Wait for a workspace to reach a status
+// Poll GetWorkspace until the status of the workspace is one of the statuses of the options (or none of the
+// WorkspacePendingStatuses) and, if requested, the workspace is unlocked, and return the final workspace. Polls
+// that fail with a retryable error (see IsRetryable) are retried; other errors end the wait. If the timeout
+// expires, a WorkspaceWaitTimeoutError with the last observed state of the workspace is returned.
+func (schematics *SchematicsV1) WaitForWorkspaceStatus(waitForWorkspaceStatusOptions *WaitForWorkspaceStatusOptions) (result *WorkspaceResponse, err error) {
+	return schematics.WaitForWorkspaceStatusWithContext(context.Background(), waitForWorkspaceStatusOptions)
+}
+
+// WaitForWorkspaceStatusWithContext is an alternate form of the WaitForWorkspaceStatus method which supports a
+// Context parameter. The wait ends with the error of the context when it is done.
+func (schematics *SchematicsV1) WaitForWorkspaceStatusWithContext(ctx context.Context, waitForWorkspaceStatusOptions *WaitForWorkspaceStatusOptions) (result *WorkspaceResponse, err error) {
+	err = core.ValidateNotNil(waitForWorkspaceStatusOptions, "waitForWorkspaceStatusOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(waitForWorkspaceStatusOptions, "waitForWorkspaceStatusOptions")
+	if err != nil {
+		return
+	}
+
+	options := waitForWorkspaceStatusOptions
+	started := time.Now()
+	var last *WorkspaceResponse
+	var lastErr error
+	var polls int
+	err = waitFor(ctx, options.Timeout, options.Backoff, func(ctx context.Context, attempt int) (bool, error) {
+		workspace, _, err := schematics.GetWorkspaceWithContext(ctx, &GetWorkspaceOptions{WID: options.WID})
+		polls = attempt + 1
+		if err != nil {
+			// A poll interrupted by the end of the wait does not report the state of the workspace.
+			if ctx.Err() == nil {
+				lastErr = err
+				if IsRetryable(err) && options.OnProgress != nil {
+					options.OnProgress(&WorkspaceWaitProgress{Polls: polls, Elapsed: time.Since(started), Err: err})
+				}
+			}
+			return false, err
+		}
+		last, lastErr = workspace, nil
+		if options.hasReached(workspace) {
+			return true, nil
+		}
+		if options.OnProgress != nil {
+			options.OnProgress(&WorkspaceWaitProgress{Polls: polls, Elapsed: time.Since(started), Workspace: workspace})
+		}
+		return false, nil
+	})
+	switch {
+	case err == errWaitTimeout:
+		return nil, &WorkspaceWaitTimeoutError{
+			WID:       *options.WID,
+			Statuses:  options.Statuses,
+			Unlocked:  options.Unlocked,
+			Elapsed:   time.Since(started),
+			Polls:     polls,
+			Workspace: last,
+			LastErr:   lastErr,
+		}
+	case err != nil:
+		return nil, err
+	}
+	return last, nil
+}
+
+// hasReached returns true if a workspace has reached the state waited for.
+func (options *WaitForWorkspaceStatusOptions) hasReached(workspace *WorkspaceResponse) bool {
+	if options.Unlocked && workspaceLocked(workspace) {
+		return false
+	}
+	status := core.StringNilMapper(workspace.Status)
+	if len(options.Statuses) == 0 {
+		return !containsStatus(WorkspacePendingStatuses, status)
+	}
+	return containsStatus(options.Statuses, status)
+}
+
+// WaitForWorkspaceActivityOptions : The options for WaitForWorkspaceActivity.
+type WaitForWorkspaceActivityOptions struct {
+	// The ID of the workspace.
+	WID *string `validate:"required,ne="`
+
+	// The ID of the activity, as returned by commands such as ApplyWorkspaceCommand.
+	ActivityID *string `validate:"required,ne="`
+
+	// If true, the logs of the templates of the activity are captured once it has ended.
+	CaptureLogs bool
+
+	// The maximum duration of the wait; DefaultWaitTimeout if 0.
+	Timeout time.Duration
+
+	// The polling schedule; the defaults of WaitBackoff if nil.
+	Backoff *WaitBackoff
+
+	// The function called after each poll that did not end the wait.
+	OnProgress func(progress *WorkspaceActivityWaitProgress)
+}
+
+// NewWaitForWorkspaceActivityOptions : Instantiate WaitForWorkspaceActivityOptions
+func (*SchematicsV1) NewWaitForWorkspaceActivityOptions(wID string, activityID string) *WaitForWorkspaceActivityOptions {
+	return &WaitForWorkspaceActivityOptions{
+		WID:        core.StringPtr(wID),
+		ActivityID: core.StringPtr(activityID),
+	}
+}
+
+// SetCaptureLogs : Allow user to set CaptureLogs
+func (_options *WaitForWorkspaceActivityOptions) SetCaptureLogs(captureLogs bool) *WaitForWorkspaceActivityOptions {
+	_options.CaptureLogs = captureLogs
+	return _options
+}
+
+// SetTimeout : Allow user to set Timeout
+func (_options *WaitForWorkspaceActivityOptions) SetTimeout(timeout time.Duration) *WaitForWorkspaceActivityOptions {
+	_options.Timeout = timeout
+	return _options
+}
+
+// SetBackoff : Allow user to set Backoff
+func (_options *WaitForWorkspaceActivityOptions) SetBackoff(backoff *WaitBackoff) *WaitForWorkspaceActivityOptions {
+	_options.Backoff = backoff
+	return _options
+}
+
+// SetOnProgress : Allow user to set OnProgress
+func (_options *WaitForWorkspaceActivityOptions) SetOnProgress(onProgress func(progress *WorkspaceActivityWaitProgress)) *WaitForWorkspaceActivityOptions {
+	_options.OnProgress = onProgress
+	return _options
+}
+
+// WorkspaceActivityWaitProgress : The state of a WaitForWorkspaceActivity after a poll that did not end it.
+type WorkspaceActivityWaitProgress struct {
+	// The number of polls so far.
+	Polls int
+
+	// The time since the wait started.
+	Elapsed time.Duration
+
+	// The activity returned by the poll, or nil if it failed.
+	Activity *WorkspaceActivity
+
+	// The retryable error of the poll, if it failed.
+	Err error
+}
+
+// WorkspaceActivityResult : The result of a WaitForWorkspaceActivity.
+type WorkspaceActivityResult struct {
+	// The activity in its terminal status, with the status and messages of its templates.
+	Activity *WorkspaceActivity
+
+	// The logs of the templates of the activity, if they were captured.
+	Logs []WorkspaceActivityTemplateLog
+}
+
+// Succeeded returns true if the activity completed successfully.
+func (result *WorkspaceActivityResult) Succeeded() bool {
+	return normalizeStatus(core.StringNilMapper(result.Activity.Status)) == normalizeStatus(ActivityStatusCompleted)
+}
+
+// WorkspaceActivityTemplateLog : The log of a template of a workspace activity.
+type WorkspaceActivityTemplateLog struct {
+	TemplateID   string
+	TemplateType string
+	LogURL       string
+
+	// The content of the log.
+	Log string
+}
+
+// ErrActivityFailed is matched by the ActivityFailedError returned by WaitForWorkspaceActivity for the activities
+// that did not complete successfully.
+var ErrActivityFailed = errors.New("workspace activity did not complete")
+
+// ActivityFailedError : The error returned by WaitForWorkspaceActivity, along with its result, when the activity
+// ended in a status other than COMPLETED. Use errors.Is(err, ErrActivityFailed) to detect it.
+type ActivityFailedError struct {
+	WID      string
+	Activity *WorkspaceActivity
+}
+
+func (err *ActivityFailedError) Error() string {
+	message := fmt.Sprintf("workspace activity '%s' of workspace '%s' ended with status '%s'",
+		core.StringNilMapper(err.Activity.ActionID), err.WID, core.StringNilMapper(err.Activity.Status))
+	if len(err.Activity.Message) != 0 {
+		message += ": " + strings.Join(err.Activity.Message, "; ")
+	}
+	return message
+}
+
+// Is returns true if "target" is ErrActivityFailed.
+func (err *ActivityFailedError) Is(target error) bool {
+	return target == ErrActivityFailed
+}
+
+// ActivityWaitTimeoutError : The error returned by WaitForWorkspaceActivity when its timeout expires. Use
+// errors.Is(err, ErrWaitTimeout) to detect it.
+type ActivityWaitTimeoutError struct {
+	WID        string
+	ActivityID string
+
+	// The duration of the wait and the number of polls.
+	Elapsed time.Duration
+	Polls   int
+
+	// The activity returned by the last successful poll, or nil if none succeeded.
+	Activity *WorkspaceActivity
+
+	// The error of the last poll, if it failed.
+	LastErr error
+}
+
+func (err *ActivityWaitTimeoutError) Error() string {
+	state := "the activity was never read"
+	if err.Activity != nil {
+		state = fmt.Sprintf("last status '%s'", core.StringNilMapper(err.Activity.Status))
+	}
+	if err.LastErr != nil {
+		state += fmt.Sprintf(", last error: %s", err.LastErr.Error())
+	}
+	return fmt.Sprintf("%s for activity '%s' of workspace '%s' after %s (%s)",
+		ErrWaitTimeout.Error(), err.ActivityID, err.WID, err.Elapsed.Round(time.Millisecond), state)
+}
+
+// Is returns true if "target" is ErrWaitTimeout.
+func (err *ActivityWaitTimeoutError) Is(target error) bool {
+	return target == ErrWaitTimeout
+}
+
+// WaitForWorkspaceActivity : Wait for a workspace activity to end
+// Poll GetWorkspaceActivity until the activity reaches one of the ActivityTerminalStatuses, then return it with,
+// if requested, the logs of its templates, read from the log URLs returned by GetWorkspaceActivityLogs.
+//
+// If the activity ends in a status other than COMPLETED, the result is returned along with an
+// ActivityFailedError. Polls that fail with a retryable error (see IsRetryable) are retried; other errors end the
+// wait. If the timeout expires, an ActivityWaitTimeoutError with the last observed state of the activity is
+// returned.
+//
+//	apply, _, err := schematicsService.ApplyWorkspaceCommand(applyWorkspaceCommandOptions)
+//	...
+//	result, err := schematicsService.WaitForWorkspaceActivity(
+//		schematicsService.NewWaitForWorkspaceActivityOptions(wID, *apply.Activityid).SetCaptureLogs(true))
+func (schematics *SchematicsV1) WaitForWorkspaceActivity(waitForWorkspaceActivityOptions *WaitForWorkspaceActivityOptions) (result *WorkspaceActivityResult, err error) {
+	return schematics.WaitForWorkspaceActivityWithContext(context.Background(), waitForWorkspaceActivityOptions)
+}
+
+// WaitForWorkspaceActivityWithContext is an alternate form of the WaitForWorkspaceActivity method which supports
+// a Context parameter. The wait ends with the error of the context when it is done.
+func (schematics *SchematicsV1) WaitForWorkspaceActivityWithContext(ctx context.Context, waitForWorkspaceActivityOptions *WaitForWorkspaceActivityOptions) (result *WorkspaceActivityResult, err error) {
+	err = core.ValidateNotNil(waitForWorkspaceActivityOptions, "waitForWorkspaceActivityOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(waitForWorkspaceActivityOptions, "waitForWorkspaceActivityOptions")
+	if err != nil {
+		return
+	}
+
+	options := waitForWorkspaceActivityOptions
+	getOptions := &GetWorkspaceActivityOptions{WID: options.WID, ActivityID: options.ActivityID}
+	started := time.Now()
+	var last *WorkspaceActivity
+	var lastErr error
+	var polls int
+	err = waitFor(ctx, options.Timeout, options.Backoff, func(ctx context.Context, attempt int) (bool, error) {
+		activity, _, err := schematics.GetWorkspaceActivityWithContext(ctx, getOptions)
+		polls = attempt + 1
+		if err != nil {
+			// A poll interrupted by the end of the wait does not report the state of the activity.
+			if ctx.Err() == nil {
+				lastErr = err
+				if IsRetryable(err) && options.OnProgress != nil {
+					options.OnProgress(&WorkspaceActivityWaitProgress{Polls: polls, Elapsed: time.Since(started), Err: err})
+				}
+			}
+			return false, err
+		}
+		last, lastErr = activity, nil
+		if containsStatus(ActivityTerminalStatuses, core.StringNilMapper(activity.Status)) {
+			return true, nil
+		}
+		if options.OnProgress != nil {
+			options.OnProgress(&WorkspaceActivityWaitProgress{Polls: polls, Elapsed: time.Since(started), Activity: activity})
+		}
+		return false, nil
+	})
+	switch {
+	case err == errWaitTimeout:
+		return nil, &ActivityWaitTimeoutError{
+			WID:        *options.WID,
+			ActivityID: *options.ActivityID,
+			Elapsed:    time.Since(started),
+			Polls:      polls,
+			Activity:   last,
+			LastErr:    lastErr,
+		}
+	case err != nil:
+		return nil, err
+	}
+
+	result = &WorkspaceActivityResult{Activity: last}
+	if options.CaptureLogs {
+		result.Logs, err = schematics.captureActivityLogs(ctx, options.WID, options.ActivityID)
+		if err != nil {
+			return result, fmt.Errorf("unable to capture the logs of activity '%s': %w", *options.ActivityID, err)
+		}
+	}
+	if !result.Succeeded() {
+		err = &ActivityFailedError{WID: *options.WID, Activity: last}
+	}
+	return
+}
+
+// captureActivityLogs reads the logs of the templates of a workspace activity.
+func (schematics *SchematicsV1) captureActivityLogs(ctx context.Context, wID *string, activityID *string) ([]WorkspaceActivityTemplateLog, error) {
+	logs, _, err := schematics.GetWorkspaceActivityLogsWithContext(ctx, &GetWorkspaceActivityLogsOptions{WID: wID, ActivityID: activityID})
+	if err != nil {
+		return nil, err
+	}
+	var templateLogs []WorkspaceActivityTemplateLog
+	for _, template := range logs.Templates {
+		templateLog := WorkspaceActivityTemplateLog{
+			TemplateID:   core.StringNilMapper(template.TemplateID),
+			TemplateType: core.StringNilMapper(template.TemplateType),
+			LogURL:       core.StringNilMapper(template.LogURL),
+		}
+		if templateLog.LogURL != "" {
+			templateLog.Log, err = schematics.readLog(ctx, templateLog.LogURL)
+			if err != nil {
+				return nil, err
+			}
+		}
+		templateLogs = append(templateLogs, templateLog)
+	}
+	return templateLogs, nil
+}
+
+// readLog reads a log from its URL, with the authentication of the instance. Log URLs are served by the service, so
+// a URL with another scheme or host is rejected rather than sent the credentials of the instance.
+func (schematics *SchematicsV1) readLog(ctx context.Context, logURL string) (string, error) {
+	serviceURL, err := url.Parse(schematics.GetServiceURL())
+	if err != nil {
+		return "", err
+	}
+	parsed, err := url.Parse(logURL)
+	if err != nil {
+		return "", err
+	}
+	resolved := serviceURL.ResolveReference(parsed)
+	if !strings.EqualFold(resolved.Scheme, serviceURL.Scheme) || !strings.EqualFold(resolved.Host, serviceURL.Host) {
+		return "", fmt.Errorf("log URL '%s' is not served by the service at '%s'", resolved.Redacted(), serviceURL.Redacted())
+	}
+
+	builder := core.NewRequestBuilder(core.GET)
+	builder = builder.WithContext(withOperation(ctx, "GetWorkspaceActivityLogs"))
+	builder.URL = resolved
+
+	sdkHeaders := common.GetSdkHeadersWithApplication("schematics", "V1", "GetWorkspaceActivityLogs", schematics.application)
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+	builder.AddHeader("Accept", "text/plain")
+
+	request, err := builder.Build()
+	if err != nil {
+		return "", err
+	}
+
+	var body io.ReadCloser
+	response, err := schematics.request(request, &body)
+	if err != nil {
+		return "", newSchematicsError(err, response)
+	}
+	if body == nil {
+		return "", nil
+	}
+	defer body.Close()
+	data, err := io.ReadAll(body)
+	return string(data), err
+}
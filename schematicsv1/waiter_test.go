@@ -0,0 +1,257 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	"github.com/IBM/schematics-go-sdk/schematicsv1/schematicstest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`WaitForWorkspaceStatus`, func() {
+	var server *schematicstest.Server
+	var schematicsService *schematicsv1.SchematicsV1
+	var workspaceID string
+
+	fastBackoff := &schematicsv1.WaitBackoff{MinInterval: time.Millisecond, MaxInterval: 5 * time.Millisecond}
+
+	BeforeEach(func() {
+		server = schematicstest.NewServer(nil)
+		var err error
+		schematicsService, err = server.NewService()
+		Expect(err).To(BeNil())
+
+		createWorkspaceOptions := schematicsService.NewCreateWorkspaceOptions()
+		createWorkspaceOptions.SetName("waiter")
+		workspace, _, err := schematicsService.CreateWorkspace(createWorkspaceOptions)
+		Expect(err).To(BeNil())
+		Expect(*workspace.Status).To(Equal(schematicsv1.WorkspaceStatusDraft))
+		workspaceID = *workspace.ID
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Waits for the workspace to leave the pending statuses`, func() {
+		workspace, err := schematicsService.WaitForWorkspaceStatus(schematicsService.NewWaitForWorkspaceStatusOptions(workspaceID).SetBackoff(fastBackoff))
+		Expect(err).To(BeNil())
+		Expect(*workspace.Status).To(Equal(schematicsv1.WorkspaceStatusInactive))
+	})
+
+	It(`Waits for a target status and for the workspace to be unlocked`, func() {
+		_, err := schematicsService.WaitForWorkspaceStatus(schematicsService.NewWaitForWorkspaceStatusOptions(workspaceID).SetBackoff(fastBackoff))
+		Expect(err).To(BeNil())
+		_, _, err = schematicsService.ApplyWorkspaceCommand(schematicsService.NewApplyWorkspaceCommandOptions(workspaceID, "token"))
+		Expect(err).To(BeNil())
+
+		var progress []*schematicsv1.WorkspaceWaitProgress
+		waitOptions := schematicsService.NewWaitForWorkspaceStatusOptions(workspaceID, "active").
+			SetUnlocked(true).
+			SetBackoff(fastBackoff).
+			SetOnProgress(func(p *schematicsv1.WorkspaceWaitProgress) { progress = append(progress, p) })
+		workspace, err := schematicsService.WaitForWorkspaceStatus(waitOptions)
+		Expect(err).To(BeNil())
+		Expect(*workspace.Status).To(Equal(schematicsv1.WorkspaceStatusActive))
+		Expect(*workspace.WorkspaceStatus.Locked).To(BeFalse())
+		Expect(progress).ToNot(BeEmpty())
+		Expect(*progress[0].Workspace.WorkspaceStatus.Locked).To(BeTrue())
+		Expect(progress[len(progress)-1].Polls).To(Equal(len(progress)))
+	})
+
+	It(`Retries the polls that fail with a retryable error`, func() {
+		server.InjectFault(schematicstest.Fault{OperationID: "GetWorkspace", StatusCode: http.StatusServiceUnavailable, Count: 2})
+		var progress []*schematicsv1.WorkspaceWaitProgress
+		waitOptions := schematicsService.NewWaitForWorkspaceStatusOptions(workspaceID, schematicsv1.WorkspaceStatusInactive).
+			SetBackoff(fastBackoff).
+			SetOnProgress(func(p *schematicsv1.WorkspaceWaitProgress) { progress = append(progress, p) })
+		workspace, err := schematicsService.WaitForWorkspaceStatus(waitOptions)
+		Expect(err).To(BeNil())
+		Expect(*workspace.Status).To(Equal(schematicsv1.WorkspaceStatusInactive))
+		Expect(progress).To(HaveLen(2))
+		Expect(progress[0].Workspace).To(BeNil())
+		Expect(schematicsv1.IsRetryable(progress[0].Err)).To(BeTrue())
+	})
+
+	It(`Ends the wait on other errors`, func() {
+		_, err := schematicsService.WaitForWorkspaceStatus(schematicsService.NewWaitForWorkspaceStatusOptions("us-south.workspace.missing.0"))
+		Expect(schematicsv1.IsNotFound(err)).To(BeTrue())
+
+		_, err = schematicsService.WaitForWorkspaceStatus(&schematicsv1.WaitForWorkspaceStatusOptions{})
+		Expect(err).ToNot(BeNil())
+	})
+
+	It(`Returns the last observed state when the timeout expires`, func() {
+		waitOptions := schematicsService.NewWaitForWorkspaceStatusOptions(workspaceID, schematicsv1.WorkspaceStatusActive).
+			SetTimeout(50 * time.Millisecond).
+			SetBackoff(&schematicsv1.WaitBackoff{MinInterval: 5 * time.Millisecond, Jitter: -1})
+		started := time.Now()
+		_, err := schematicsService.WaitForWorkspaceStatus(waitOptions)
+		Expect(time.Since(started)).To(BeNumerically("<", time.Second))
+		Expect(errors.Is(err, schematicsv1.ErrWaitTimeout)).To(BeTrue())
+		var timeoutErr *schematicsv1.WorkspaceWaitTimeoutError
+		Expect(errors.As(err, &timeoutErr)).To(BeTrue())
+		Expect(timeoutErr.WID).To(Equal(workspaceID))
+		Expect(timeoutErr.Polls).To(BeNumerically(">", 1))
+		Expect(*timeoutErr.Workspace.Status).To(Equal(schematicsv1.WorkspaceStatusInactive))
+		Expect(err.Error()).To(ContainSubstring("last status 'INACTIVE'"))
+	})
+
+	It(`Ends the wait when the context is done`, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		waitOptions := schematicsService.NewWaitForWorkspaceStatusOptions(workspaceID, schematicsv1.WorkspaceStatusActive).
+			SetBackoff(fastBackoff).
+			SetOnProgress(func(p *schematicsv1.WorkspaceWaitProgress) { cancel() })
+		_, err := schematicsService.WaitForWorkspaceStatusWithContext(ctx, waitOptions)
+		Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+	})
+})
+
+var _ = Describe(`WaitForWorkspaceActivity`, func() {
+	var server *schematicstest.Server
+	var schematicsService *schematicsv1.SchematicsV1
+	var workspaceID string
+
+	fastBackoff := &schematicsv1.WaitBackoff{MinInterval: time.Millisecond, MaxInterval: 5 * time.Millisecond}
+
+	newServer := func(options *schematicstest.ServerOptions) {
+		server = schematicstest.NewServer(options)
+		var err error
+		schematicsService, err = server.NewService()
+		Expect(err).To(BeNil())
+
+		createWorkspaceOptions := schematicsService.NewCreateWorkspaceOptions()
+		createWorkspaceOptions.SetName("activity")
+		workspace, _, err := schematicsService.CreateWorkspace(createWorkspaceOptions)
+		Expect(err).To(BeNil())
+		workspaceID = *workspace.ID
+		_, err = schematicsService.WaitForWorkspaceStatus(schematicsService.NewWaitForWorkspaceStatusOptions(workspaceID).SetBackoff(fastBackoff))
+		Expect(err).To(BeNil())
+	}
+
+	apply := func() string {
+		result, _, err := schematicsService.ApplyWorkspaceCommand(schematicsService.NewApplyWorkspaceCommandOptions(workspaceID, "token"))
+		Expect(err).To(BeNil())
+		return *result.Activityid
+	}
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Returns the activity once it has completed`, func() {
+		newServer(nil)
+		activityID := apply()
+
+		var progress []*schematicsv1.WorkspaceActivityWaitProgress
+		waitOptions := schematicsService.NewWaitForWorkspaceActivityOptions(workspaceID, activityID).
+			SetBackoff(fastBackoff).
+			SetOnProgress(func(p *schematicsv1.WorkspaceActivityWaitProgress) { progress = append(progress, p) })
+		result, err := schematicsService.WaitForWorkspaceActivity(waitOptions)
+		Expect(err).To(BeNil())
+		Expect(result.Succeeded()).To(BeTrue())
+		Expect(*result.Activity.ActionID).To(Equal(activityID))
+		Expect(*result.Activity.Status).To(Equal(schematicsv1.ActivityStatusCompleted))
+		Expect(result.Logs).To(BeEmpty())
+		Expect(progress).ToNot(BeEmpty())
+		Expect(*progress[0].Activity.Status).To(Equal(schematicsv1.ActivityStatusInProgress))
+	})
+
+	It(`Captures the logs of the activity`, func() {
+		newServer(nil)
+		activityID := apply()
+
+		waitOptions := schematicsService.NewWaitForWorkspaceActivityOptions(workspaceID, activityID).
+			SetBackoff(fastBackoff).
+			SetCaptureLogs(true)
+		result, err := schematicsService.WaitForWorkspaceActivity(waitOptions)
+		Expect(err).To(BeNil())
+		Expect(result.Logs).To(HaveLen(1))
+		Expect(result.Logs[0].LogURL).ToNot(BeEmpty())
+		Expect(result.Logs[0].Log).To(ContainSubstring("Activity " + activityID))
+	})
+
+	It(`Returns the error of a log that cannot be read`, func() {
+		newServer(nil)
+		activityID := apply()
+		server.InjectFault(schematicstest.Fault{PathPrefix: "/logs/", StatusCode: http.StatusInternalServerError})
+
+		waitOptions := schematicsService.NewWaitForWorkspaceActivityOptions(workspaceID, activityID).
+			SetBackoff(fastBackoff).
+			SetCaptureLogs(true)
+		_, err := schematicsService.WaitForWorkspaceActivity(waitOptions)
+		Expect(err).ToNot(BeNil())
+		var schematicsError *schematicsv1.SchematicsError
+		Expect(errors.As(err, &schematicsError)).To(BeTrue())
+		Expect(schematicsError.StatusCode).To(Equal(http.StatusInternalServerError))
+	})
+
+	It(`Does not send the credentials to a log URL of another host`, func() {
+		newServer(nil)
+		activityID := apply()
+		// The log URLs of the fake service use 127.0.0.1, which is another host than localhost.
+		Expect(schematicsService.SetServiceURL(strings.Replace(server.URL, "127.0.0.1", "localhost", 1))).To(Succeed())
+
+		waitOptions := schematicsService.NewWaitForWorkspaceActivityOptions(workspaceID, activityID).
+			SetBackoff(fastBackoff).
+			SetCaptureLogs(true)
+		_, err := schematicsService.WaitForWorkspaceActivity(waitOptions)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("is not served by the service"))
+		for _, request := range server.Requests() {
+			Expect(request.Path).ToNot(HavePrefix("/logs/"))
+		}
+	})
+
+	It(`Returns the result with an ActivityFailedError when the activity fails`, func() {
+		newServer(&schematicstest.ServerOptions{Fail: func(command string, resourceID string) bool { return true }})
+		activityID := apply()
+
+		result, err := schematicsService.WaitForWorkspaceActivity(schematicsService.NewWaitForWorkspaceActivityOptions(workspaceID, activityID).SetBackoff(fastBackoff))
+		Expect(errors.Is(err, schematicsv1.ErrActivityFailed)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("The apply job failed."))
+		Expect(result.Succeeded()).To(BeFalse())
+		Expect(*result.Activity.Status).To(Equal(schematicsv1.ActivityStatusFailed))
+	})
+
+	It(`Returns the last observed state when the timeout expires`, func() {
+		newServer(nil)
+		activityID := apply()
+		server.InjectFault(schematicstest.Fault{OperationID: "GetWorkspaceActivity", StatusCode: http.StatusBadGateway})
+
+		waitOptions := schematicsService.NewWaitForWorkspaceActivityOptions(workspaceID, activityID).
+			SetTimeout(50 * time.Millisecond).
+			SetBackoff(fastBackoff)
+		_, err := schematicsService.WaitForWorkspaceActivity(waitOptions)
+		Expect(errors.Is(err, schematicsv1.ErrWaitTimeout)).To(BeTrue())
+		var timeoutErr *schematicsv1.ActivityWaitTimeoutError
+		Expect(errors.As(err, &timeoutErr)).To(BeTrue())
+		Expect(timeoutErr.ActivityID).To(Equal(activityID))
+		Expect(timeoutErr.Activity).To(BeNil())
+		Expect(schematicsv1.IsRetryable(timeoutErr.LastErr)).To(BeTrue())
+
+		_, err = schematicsService.WaitForWorkspaceActivity(schematicsService.NewWaitForWorkspaceActivityOptions(workspaceID, ""))
+		Expect(err).ToNot(BeNil())
+		Expect(errors.Is(err, schematicsv1.ErrWaitTimeout)).To(BeFalse())
+	})
+})
@@ -0,0 +1,478 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+)
+
+// ErrPlanRejected is returned by WorkspaceRunner.Run, along with its result, when the approval hook rejects the
+// plan. The plan activity has already ended, so nothing is canceled on the service: the plan remains in the
+// history of the workspace and nothing is applied.
+var ErrPlanRejected = errors.New("plan rejected")
+
+// stopActivityTimeout is the time allowed to stop the activity of a canceled run.
+const stopActivityTimeout = 30 * time.Second
+
+// PlanFileType is the type of the job file that holds the Terraform plan in JSON, see GetJobFiles.
+const PlanFileType = "plan_json"
+
+// RunEventType : The type of a RunEvent.
+type RunEventType string
+
+// The types of the events of a run, in the order in which they are emitted.
+const (
+	RunEventStarted           RunEventType = "run_started"
+	RunEventPullStarted       RunEventType = "pull_started"
+	RunEventPullCompleted     RunEventType = "pull_completed"
+	RunEventPlanStarted       RunEventType = "plan_started"
+	RunEventPlanProgress      RunEventType = "plan_progress"
+	RunEventPlanCompleted     RunEventType = "plan_completed"
+	RunEventApprovalRequested RunEventType = "approval_requested"
+	RunEventApproved          RunEventType = "approved"
+	RunEventRejected          RunEventType = "rejected"
+	RunEventApplyStarted      RunEventType = "apply_started"
+	RunEventApplyProgress     RunEventType = "apply_progress"
+	RunEventApplyCompleted    RunEventType = "apply_completed"
+
+	// A run ends with exactly one of these events.
+	RunEventCompleted RunEventType = "run_completed"
+	RunEventCanceled  RunEventType = "run_canceled"
+	RunEventFailed    RunEventType = "run_failed"
+)
+
+// RunEvent : An event of a run of a WorkspaceRunner.
+type RunEvent struct {
+	Type RunEventType
+	Time time.Time
+
+	// The ID of the workspace of the run.
+	WID string
+
+	// The ID of the plan or apply activity, for the events of these stages.
+	ActivityID string
+
+	// The status of the workspace, for the pull events, or of the activity, for the plan and apply events.
+	Status string
+
+	// The summary of the plan, for the plan_completed, approval_requested, approved and rejected events.
+	Plan *PlanSummary
+
+	// The error that ended the run, for the run_failed and run_canceled events. A run is canceled when its plan is
+	// rejected or its context is done.
+	Err error
+}
+
+// PlanSummary : The summary of a plan activity, presented to the approval hook of a WorkspaceRunner.
+type PlanSummary struct {
+	WID        string
+	ActivityID string
+
+	// The plan activity, with the status, messages and log summary of each template.
+	Activity *WorkspaceActivity
+
+	// The summary extracted by Schematics from the logs of the plan job, if available.
+	LogSummary *JobLogSummary
+
+	// The number of resources that the plan adds, modifies and destroys, from the log summary of the job or, if
+	// it is not available, from the log summaries of the templates.
+	ResourcesAdded     int64
+	ResourcesModified  int64
+	ResourcesDestroyed int64
+
+	// The plan in JSON, from the plan_json job file, if available.
+	PlanJSON string
+
+	// The logs of the templates of the plan activity, if they were captured.
+	Logs []WorkspaceActivityTemplateLog
+}
+
+// HasChanges returns true if the plan adds, modifies or destroys resources.
+func (plan *PlanSummary) HasChanges() bool {
+	return plan.ResourcesAdded != 0 || plan.ResourcesModified != 0 || plan.ResourcesDestroyed != 0
+}
+
+// ApprovalFunc : The approval hook of a WorkspaceRunner. It is called with the summary of a successful plan and
+// returns true to apply it, or false to reject it. It may block, for example until a reviewer decides, and should
+// return the error of "ctx" when it is done.
+type ApprovalFunc func(ctx context.Context, plan *PlanSummary) (approved bool, err error)
+
+// AutoApprove is an ApprovalFunc that approves every plan.
+func AutoApprove(ctx context.Context, plan *PlanSummary) (bool, error) {
+	return true, nil
+}
+
+// WorkspaceRunnerOptions : The options for NewWorkspaceRunner.
+type WorkspaceRunnerOptions struct {
+	// The approval hook of the plans.
+	Approve ApprovalFunc
+
+	// The refresh token of the plan and apply commands. If empty, it must be supplied by the TokenProvider of the
+	// instance, see SetTokenProvider.
+	RefreshToken string
+
+	// If true, the workspace does not pull the latest version of its template repository before the plan.
+	SkipPull bool
+
+	// If true, the logs of the plan and apply activities are captured.
+	CaptureLogs bool
+
+	// The maximum duration of each wait, for the workspace to be ready and for the plan and apply activities to
+	// end; DefaultWaitTimeout if 0.
+	Timeout time.Duration
+
+	// The polling schedule of the waits; the defaults of WaitBackoff if nil.
+	Backoff *WaitBackoff
+
+	// The function called with each event of the runs.
+	OnEvent func(event RunEvent)
+}
+
+// RunResult : The result of a run of a WorkspaceRunner.
+type RunResult struct {
+	WID string
+
+	// The summary of the plan, or nil if the run ended before the plan did.
+	Plan *PlanSummary
+
+	// True if the approval hook approved the plan.
+	Approved bool
+
+	// The result of the apply activity, or nil if the plan was not applied.
+	Apply *WorkspaceActivityResult
+
+	// The workspace after the run, if it was read: after the apply, or after the pull if the plan was rejected.
+	Workspace *WorkspaceResponse
+}
+
+// WorkspaceRunner : Runs the standard Terraform flow on workspaces: pull the latest version of the template
+// repository, plan, summarize the plan, ask the approval hook and, if it approves, apply.
+type WorkspaceRunner struct {
+	schematics *SchematicsV1
+	options    WorkspaceRunnerOptions
+}
+
+// NewWorkspaceRunner constructs a WorkspaceRunner that runs on the workspaces of "schematics". The options must
+// have an approval hook.
+func NewWorkspaceRunner(schematics *SchematicsV1, options *WorkspaceRunnerOptions) (*WorkspaceRunner, error) {
+	if err := core.ValidateNotNil(options, "options cannot be nil"); err != nil {
+		return nil, err
+	}
+	if options.Approve == nil {
+		return nil, errors.New("options.Approve cannot be nil; use AutoApprove to approve every plan")
+	}
+	return &WorkspaceRunner{schematics: schematics, options: *options}, nil
+}
+
+// Run runs the flow on the workspace "wID":
+//
+//  1. Unless SkipPull is set, the workspace pulls the latest version of its template repository, and the run
+//     waits for the pull to start, as seen in the status, update time or lock of the workspace, and then for the
+//     workspace to be ready and unlocked.
+//  2. A plan is run, and the run waits for it to end.
+//  3. The plan is summarized from its activity, the log summary of its job and the plan_json job file.
+//  4. The approval hook is called with the summary.
+//  5. If the hook approves the plan, it is applied and the run waits for the apply to end; otherwise the run is
+//     canceled with ErrPlanRejected. The rejected plan is not discarded on the service.
+//
+// Each step emits events to OnEvent. The result is returned with the error that ended the run, if any: an
+// ActivityFailedError if the plan or the apply failed, ErrPlanRejected, or the error of "ctx" if it is done, in
+// which case the running activity is stopped.
+func (runner *WorkspaceRunner) Run(ctx context.Context, wID string) (result *RunResult, err error) {
+	result = &RunResult{WID: wID}
+	runner.emit(RunEvent{Type: RunEventStarted, WID: wID})
+	defer func() {
+		switch {
+		case err == nil:
+			runner.emit(RunEvent{Type: RunEventCompleted, WID: wID})
+		case errors.Is(err, ErrPlanRejected) || ctx.Err() != nil:
+			runner.emit(RunEvent{Type: RunEventCanceled, WID: wID, Err: err})
+		default:
+			runner.emit(RunEvent{Type: RunEventFailed, WID: wID, Err: err})
+		}
+	}()
+
+	result.Workspace, err = runner.pull(ctx, wID)
+	if err != nil {
+		return
+	}
+
+	result.Plan, err = runner.plan(ctx, wID)
+	if err != nil {
+		return
+	}
+
+	runner.emit(RunEvent{Type: RunEventApprovalRequested, WID: wID, ActivityID: result.Plan.ActivityID, Plan: result.Plan})
+	result.Approved, err = runner.options.Approve(ctx, result.Plan)
+	if err != nil {
+		err = fmt.Errorf("approval of plan '%s' failed: %w", result.Plan.ActivityID, err)
+		return
+	}
+	if !result.Approved {
+		runner.emit(RunEvent{Type: RunEventRejected, WID: wID, ActivityID: result.Plan.ActivityID, Plan: result.Plan})
+		err = ErrPlanRejected
+		return
+	}
+	runner.emit(RunEvent{Type: RunEventApproved, WID: wID, ActivityID: result.Plan.ActivityID, Plan: result.Plan})
+
+	result.Apply, err = runner.apply(ctx, wID)
+	if result.Apply != nil {
+		result.Workspace, _, _ = runner.schematics.GetWorkspaceWithContext(ctx, &GetWorkspaceOptions{WID: &wID})
+	}
+	return
+}
+
+// pull pulls the latest version of the template repository of a workspace, and waits for it to be ready.
+func (runner *WorkspaceRunner) pull(ctx context.Context, wID string) (*WorkspaceResponse, error) {
+	if !runner.options.SkipPull {
+		workspace, _, err := runner.schematics.GetWorkspaceWithContext(ctx, &GetWorkspaceOptions{WID: &wID})
+		if err != nil {
+			return nil, err
+		}
+		runner.emit(RunEvent{Type: RunEventPullStarted, WID: wID, Status: core.StringNilMapper(workspace.Status)})
+		// Workspaces without a repository, e.g. with an uploaded template, have nothing to pull.
+		if repo := workspace.TemplateRepo; repo != nil && repo.URL != nil && *repo.URL != "" {
+			updateWorkspaceOptions := &UpdateWorkspaceOptions{
+				WID: &wID,
+				TemplateRepo: &TemplateRepoUpdateRequest{
+					URL:     repo.URL,
+					Branch:  repo.Branch,
+					Release: repo.Release,
+				},
+			}
+			updated, _, err := runner.schematics.UpdateWorkspaceWithContext(ctx, updateWorkspaceOptions)
+			if err != nil {
+				return nil, err
+			}
+			if err = runner.waitForPull(ctx, wID, updated); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	waitOptions := &WaitForWorkspaceStatusOptions{
+		WID:      &wID,
+		Unlocked: true,
+		Timeout:  runner.options.Timeout,
+		Backoff:  runner.options.Backoff,
+	}
+	workspace, err := runner.schematics.WaitForWorkspaceStatusWithContext(ctx, waitOptions)
+	if err != nil {
+		return nil, err
+	}
+	if !runner.options.SkipPull {
+		runner.emit(RunEvent{Type: RunEventPullCompleted, WID: wID, Status: core.StringNilMapper(workspace.Status)})
+	}
+	return workspace, nil
+}
+
+// waitForPull waits for the pull started by an update of a workspace to be visible: until the workspace is locked,
+// or its status or update time differs from "updated", the workspace returned by the update. Until then, the
+// workspace may still be ready with its previous template, which the wait for it to be unlocked would accept. If
+// the pull does not become visible before the timeout, a WorkspaceWaitTimeoutError is returned.
+func (runner *WorkspaceRunner) waitForPull(ctx context.Context, wID string, updated *WorkspaceResponse) error {
+	// The pull is already visible in the response of the update.
+	if updated == nil || workspaceLocked(updated) || containsStatus(WorkspacePendingStatuses, core.StringNilMapper(updated.Status)) {
+		return nil
+	}
+	pulling := func(workspace *WorkspaceResponse) bool {
+		return workspaceLocked(workspace) ||
+			core.StringNilMapper(workspace.Status) != core.StringNilMapper(updated.Status) ||
+			!sameTime(workspace.UpdatedAt, updated.UpdatedAt)
+	}
+
+	started := time.Now()
+	var last *WorkspaceResponse
+	var lastErr error
+	var polls int
+	err := waitFor(ctx, runner.options.Timeout, runner.options.Backoff, func(ctx context.Context, attempt int) (bool, error) {
+		workspace, _, err := runner.schematics.GetWorkspaceWithContext(ctx, &GetWorkspaceOptions{WID: &wID})
+		polls = attempt + 1
+		if err != nil {
+			if ctx.Err() == nil {
+				lastErr = err
+			}
+			return false, err
+		}
+		last, lastErr = workspace, nil
+		return pulling(workspace), nil
+	})
+	if err == errWaitTimeout {
+		return &WorkspaceWaitTimeoutError{
+			WID:       wID,
+			Unlocked:  true,
+			Elapsed:   time.Since(started),
+			Polls:     polls,
+			Workspace: last,
+			LastErr:   lastErr,
+		}
+	}
+	return err
+}
+
+// sameTime returns true if two optional times are both nil or equal.
+func sameTime(a *strfmt.DateTime, b *strfmt.DateTime) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return time.Time(*a).Equal(time.Time(*b))
+}
+
+// refreshToken returns the refresh token of the commands, or nil to have it supplied by the TokenProvider.
+func (runner *WorkspaceRunner) refreshToken() *string {
+	if runner.options.RefreshToken == "" {
+		return nil
+	}
+	return core.StringPtr(runner.options.RefreshToken)
+}
+
+// plan runs a plan on a workspace, waits for it to end and summarizes it.
+func (runner *WorkspaceRunner) plan(ctx context.Context, wID string) (*PlanSummary, error) {
+	planResult, _, err := runner.schematics.PlanWorkspaceCommandWithContext(ctx, &PlanWorkspaceCommandOptions{
+		WID:          &wID,
+		RefreshToken: runner.refreshToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	activityID := core.StringNilMapper(planResult.Activityid)
+	runner.emit(RunEvent{Type: RunEventPlanStarted, WID: wID, ActivityID: activityID})
+
+	activityResult, err := runner.wait(ctx, wID, activityID, RunEventPlanProgress)
+	if activityResult == nil {
+		return nil, err
+	}
+	plan := &PlanSummary{
+		WID:        wID,
+		ActivityID: activityID,
+		Activity:   activityResult.Activity,
+		Logs:       activityResult.Logs,
+	}
+	if err != nil {
+		return plan, err
+	}
+	if err = runner.summarize(ctx, plan); err != nil {
+		return plan, err
+	}
+	runner.emit(RunEvent{Type: RunEventPlanCompleted, WID: wID, ActivityID: activityID, Status: core.StringNilMapper(plan.Activity.Status), Plan: plan})
+	return plan, nil
+}
+
+// summarize completes the summary of a plan from the log summary and the files of its job. The job and its plan
+// file are optional, since not every service version exposes the activities of workspaces as jobs.
+func (runner *WorkspaceRunner) summarize(ctx context.Context, plan *PlanSummary) error {
+	job, _, err := runner.schematics.GetJobWithContext(ctx, &GetJobOptions{JobID: &plan.ActivityID})
+	if err != nil && !IsNotFound(err) {
+		return err
+	}
+	if job != nil && job.LogSummary != nil {
+		plan.LogSummary = job.LogSummary
+	}
+	if plan.LogSummary != nil && plan.LogSummary.WorkspaceJob != nil {
+		workspaceJob := plan.LogSummary.WorkspaceJob
+		plan.ResourcesAdded = int64(valueOf(workspaceJob.ResourcesAdd))
+		plan.ResourcesModified = int64(valueOf(workspaceJob.ResourcesModify))
+		plan.ResourcesDestroyed = int64(valueOf(workspaceJob.ResourcesDestroy))
+	} else {
+		for _, template := range plan.Activity.Templates {
+			if template.LogSummary != nil {
+				plan.ResourcesAdded += valueOf(template.LogSummary.ResourcesAdded)
+				plan.ResourcesModified += valueOf(template.LogSummary.ResourcesModified)
+				plan.ResourcesDestroyed += valueOf(template.LogSummary.ResourcesDestroyed)
+			}
+		}
+	}
+
+	files, _, err := runner.schematics.GetJobFilesWithContext(ctx, &GetJobFilesOptions{
+		JobID:    &plan.ActivityID,
+		FileType: core.StringPtr(PlanFileType),
+	})
+	if err != nil && !IsNotFound(err) {
+		return err
+	}
+	if files != nil {
+		plan.PlanJSON = core.StringNilMapper(files.FileContent)
+	}
+	return nil
+}
+
+// apply runs an apply on a workspace and waits for it to end.
+func (runner *WorkspaceRunner) apply(ctx context.Context, wID string) (*WorkspaceActivityResult, error) {
+	applyResult, _, err := runner.schematics.ApplyWorkspaceCommandWithContext(ctx, &ApplyWorkspaceCommandOptions{
+		WID:          &wID,
+		RefreshToken: runner.refreshToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	activityID := core.StringNilMapper(applyResult.Activityid)
+	runner.emit(RunEvent{Type: RunEventApplyStarted, WID: wID, ActivityID: activityID})
+
+	activityResult, err := runner.wait(ctx, wID, activityID, RunEventApplyProgress)
+	if activityResult != nil {
+		runner.emit(RunEvent{Type: RunEventApplyCompleted, WID: wID, ActivityID: activityID, Status: core.StringNilMapper(activityResult.Activity.Status)})
+	}
+	return activityResult, err
+}
+
+// wait waits for an activity to end, emitting progress events of type "progress". The activity is stopped if
+// "ctx" is done before it ends.
+func (runner *WorkspaceRunner) wait(ctx context.Context, wID string, activityID string, progress RunEventType) (*WorkspaceActivityResult, error) {
+	waitOptions := &WaitForWorkspaceActivityOptions{
+		WID:         &wID,
+		ActivityID:  &activityID,
+		CaptureLogs: runner.options.CaptureLogs,
+		Timeout:     runner.options.Timeout,
+		Backoff:     runner.options.Backoff,
+		OnProgress: func(p *WorkspaceActivityWaitProgress) {
+			if p.Activity != nil {
+				runner.emit(RunEvent{Type: progress, WID: wID, ActivityID: activityID, Status: core.StringNilMapper(p.Activity.Status)})
+			}
+		},
+	}
+	result, err := runner.schematics.WaitForWorkspaceActivityWithContext(ctx, waitOptions)
+	if ctx.Err() != nil {
+		// The context of the run is done, so the activity is stopped with a context of its own.
+		stopCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), stopActivityTimeout)
+		defer cancel()
+		_, _, _ = runner.schematics.DeleteWorkspaceActivityWithContext(stopCtx, &DeleteWorkspaceActivityOptions{WID: &wID, ActivityID: &activityID})
+		return nil, ctx.Err()
+	}
+	return result, err
+}
+
+// valueOf returns the value of a pointer, or the zero value if it is nil.
+func valueOf[T any](pointer *T) (value T) {
+	if pointer != nil {
+		value = *pointer
+	}
+	return
+}
+
+// emit calls OnEvent with an event.
+func (runner *WorkspaceRunner) emit(event RunEvent) {
+	if runner.options.OnEvent != nil {
+		event.Time = time.Now()
+		runner.options.OnEvent(event)
+	}
+}
@@ -0,0 +1,221 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	"github.com/IBM/schematics-go-sdk/schematicsv1/schematicstest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`WorkspaceRunner`, func() {
+	var server *schematicstest.Server
+	var schematicsService *schematicsv1.SchematicsV1
+	var workspaceID string
+	var events []schematicsv1.RunEvent
+
+	newServer := func(options *schematicstest.ServerOptions) {
+		server = schematicstest.NewServer(options)
+		var err error
+		schematicsService, err = server.NewService()
+		Expect(err).To(BeNil())
+
+		createWorkspaceOptions := schematicsService.NewCreateWorkspaceOptions()
+		createWorkspaceOptions.SetName("runner")
+		createWorkspaceOptions.SetTemplateRepo(&schematicsv1.TemplateRepoRequest{
+			URL:    core.StringPtr("https://github.com/org/repo"),
+			Branch: core.StringPtr("main"),
+		})
+		workspace, _, err := schematicsService.CreateWorkspace(createWorkspaceOptions)
+		Expect(err).To(BeNil())
+		workspaceID = *workspace.ID
+		events = nil
+	}
+
+	newRunner := func(approve schematicsv1.ApprovalFunc) *schematicsv1.WorkspaceRunner {
+		runner, err := schematicsv1.NewWorkspaceRunner(schematicsService, &schematicsv1.WorkspaceRunnerOptions{
+			Approve:      approve,
+			RefreshToken: "token",
+			Backoff:      &schematicsv1.WaitBackoff{MinInterval: time.Millisecond, MaxInterval: 5 * time.Millisecond},
+			OnEvent:      func(event schematicsv1.RunEvent) { events = append(events, event) },
+		})
+		Expect(err).To(BeNil())
+		return runner
+	}
+
+	eventTypes := func() []schematicsv1.RunEventType {
+		var types []schematicsv1.RunEventType
+		for _, event := range events {
+			// The number of progress events depends on the polls.
+			if event.Type != schematicsv1.RunEventPlanProgress && event.Type != schematicsv1.RunEventApplyProgress {
+				types = append(types, event.Type)
+			}
+		}
+		return types
+	}
+
+	operations := func() []string {
+		var operationIDs []string
+		for _, request := range server.Requests() {
+			operationIDs = append(operationIDs, request.OperationID)
+		}
+		return operationIDs
+	}
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Pulls, plans, summarizes and applies an approved plan`, func() {
+		newServer(nil)
+		var approved *schematicsv1.PlanSummary
+		runner := newRunner(func(ctx context.Context, plan *schematicsv1.PlanSummary) (bool, error) {
+			approved = plan
+			return true, nil
+		})
+
+		result, err := runner.Run(context.Background(), workspaceID)
+		Expect(err).To(BeNil())
+		Expect(result.Approved).To(BeTrue())
+		Expect(result.Plan).To(BeIdenticalTo(approved))
+		Expect(result.Plan.ActivityID).ToNot(BeEmpty())
+		Expect(*result.Plan.Activity.Status).To(Equal(schematicsv1.ActivityStatusCompleted))
+		Expect(result.Plan.LogSummary.WorkspaceJob).ToNot(BeNil())
+		Expect(result.Plan.HasChanges()).To(BeFalse())
+		Expect(result.Plan.PlanJSON).To(Equal("{}"))
+		Expect(result.Apply.Succeeded()).To(BeTrue())
+		Expect(*result.Workspace.Status).To(Equal(schematicsv1.WorkspaceStatusActive))
+
+		Expect(eventTypes()).To(Equal([]schematicsv1.RunEventType{
+			schematicsv1.RunEventStarted,
+			schematicsv1.RunEventPullStarted,
+			schematicsv1.RunEventPullCompleted,
+			schematicsv1.RunEventPlanStarted,
+			schematicsv1.RunEventPlanCompleted,
+			schematicsv1.RunEventApprovalRequested,
+			schematicsv1.RunEventApproved,
+			schematicsv1.RunEventApplyStarted,
+			schematicsv1.RunEventApplyCompleted,
+			schematicsv1.RunEventCompleted,
+		}))
+		for _, event := range events {
+			Expect(event.WID).To(Equal(workspaceID))
+			Expect(event.Time.IsZero()).To(BeFalse())
+		}
+		Expect(operations()).To(ContainElements("UpdateWorkspace", "PlanWorkspaceCommand", "GetJobFiles", "ApplyWorkspaceCommand"))
+	})
+
+	It(`Cancels the run when the plan is rejected`, func() {
+		newServer(nil)
+		runner := newRunner(func(ctx context.Context, plan *schematicsv1.PlanSummary) (bool, error) {
+			return false, nil
+		})
+
+		result, err := runner.Run(context.Background(), workspaceID)
+		Expect(errors.Is(err, schematicsv1.ErrPlanRejected)).To(BeTrue())
+		Expect(result.Approved).To(BeFalse())
+		Expect(result.Plan).ToNot(BeNil())
+		Expect(result.Apply).To(BeNil())
+		Expect(eventTypes()[len(eventTypes())-2:]).To(Equal([]schematicsv1.RunEventType{schematicsv1.RunEventRejected, schematicsv1.RunEventCanceled}))
+		Expect(operations()).ToNot(ContainElement("ApplyWorkspaceCommand"))
+		Expect(operations()).ToNot(ContainElement("DeleteWorkspaceActivity"))
+	})
+
+	It(`Waits for the pull to start and end before the plan`, func() {
+		newServer(nil)
+		var pulled *schematicsv1.RunEvent
+		runner, err := schematicsv1.NewWorkspaceRunner(schematicsService, &schematicsv1.WorkspaceRunnerOptions{
+			Approve:      schematicsv1.AutoApprove,
+			RefreshToken: "token",
+			Backoff:      &schematicsv1.WaitBackoff{MinInterval: time.Millisecond, MaxInterval: 5 * time.Millisecond},
+			OnEvent: func(event schematicsv1.RunEvent) {
+				if event.Type == schematicsv1.RunEventPullCompleted {
+					pulled = &event
+				}
+			},
+		})
+		Expect(err).To(BeNil())
+
+		_, err = runner.Run(context.Background(), workspaceID)
+		Expect(err).To(BeNil())
+		Expect(pulled).ToNot(BeNil())
+		Expect(pulled.Status).To(Equal(schematicsv1.WorkspaceStatusInactive))
+
+		// The fake pull starts after the first read that follows the update, and ends on the read after it.
+		ops := operations()
+		update := slices.Index(ops, "UpdateWorkspace")
+		plan := slices.Index(ops, "PlanWorkspaceCommand")
+		Expect(update).To(BeNumerically(">=", 0))
+		Expect(plan).To(BeNumerically(">", update))
+		Expect(ops[update+1 : plan]).To(Equal([]string{"GetWorkspace", "GetWorkspace", "GetWorkspace"}))
+	})
+
+	It(`Fails the run when the plan fails`, func() {
+		newServer(&schematicstest.ServerOptions{Fail: func(command string, resourceID string) bool {
+			return command == schematicstest.CommandPlan
+		}})
+		runner := newRunner(func(ctx context.Context, plan *schematicsv1.PlanSummary) (bool, error) {
+			Fail("the approval hook was called for a failed plan")
+			return false, nil
+		})
+
+		result, err := runner.Run(context.Background(), workspaceID)
+		Expect(errors.Is(err, schematicsv1.ErrActivityFailed)).To(BeTrue())
+		Expect(*result.Plan.Activity.Status).To(Equal(schematicsv1.ActivityStatusFailed))
+		Expect(events[len(events)-1].Type).To(Equal(schematicsv1.RunEventFailed))
+		Expect(events[len(events)-1].Err).To(Equal(err))
+	})
+
+	It(`Stops the running activity when the context is done`, func() {
+		newServer(nil)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		runner, err := schematicsv1.NewWorkspaceRunner(schematicsService, &schematicsv1.WorkspaceRunnerOptions{
+			Approve:      schematicsv1.AutoApprove,
+			RefreshToken: "token",
+			SkipPull:     true,
+			Backoff:      &schematicsv1.WaitBackoff{MinInterval: time.Millisecond, MaxInterval: 5 * time.Millisecond},
+			OnEvent: func(event schematicsv1.RunEvent) {
+				events = append(events, event)
+				if event.Type == schematicsv1.RunEventApplyStarted {
+					cancel()
+				}
+			},
+		})
+		Expect(err).To(BeNil())
+
+		result, err := runner.Run(ctx, workspaceID)
+		Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+		Expect(result.Approved).To(BeTrue())
+		Expect(operations()).ToNot(ContainElement("UpdateWorkspace"))
+		Expect(operations()).To(ContainElement("DeleteWorkspaceActivity"))
+		Expect(events[len(events)-1].Type).To(Equal(schematicsv1.RunEventCanceled))
+	})
+
+	It(`Requires an approval hook`, func() {
+		newServer(nil)
+		_, err := schematicsv1.NewWorkspaceRunner(schematicsService, &schematicsv1.WorkspaceRunnerOptions{})
+		Expect(err).ToNot(BeNil())
+	})
+})